@@ -5,6 +5,7 @@ import "github.com/craiggwilson/go-collections/iter"
 type ReadOnly[K comparable, V any] interface {
 	iter.Iterer[iter.KeyValuePair[K, V]]
 
+	All() iter.Seq2[K, V]
 	Contains(K) bool
 	Keys() iter.Iterer[K]
 	Len() int
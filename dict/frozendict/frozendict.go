@@ -15,6 +15,10 @@ type FrozenDict[K comparable, V any] struct {
 	d dict.ReadOnly[K, V]
 }
 
+func (d *FrozenDict[K, V]) All() iter.Seq2[K, V] {
+	return d.d.All()
+}
+
 func (d *FrozenDict[K, V]) Contains(k K) bool {
 	return d.d.Contains(k)
 }
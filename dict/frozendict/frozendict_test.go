@@ -0,0 +1,39 @@
+package frozendict_test
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/dict/frozendict"
+	"github.com/craiggwilson/go-collections/dict/mapdict"
+)
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	d := frozendict.New[string, int](newDict())
+
+	values := map[string]int{}
+	for k, v := range d.All() {
+		values[k] = v
+	}
+
+	must.Eq(t, map[string]int{"a": 1, "b": 2, "c": 3}, values)
+
+	count := 0
+	for range d.All() {
+		count++
+		break
+	}
+
+	must.Eq(t, 1, count)
+}
+
+func newDict() *mapdict.MapDict[string, int] {
+	d := mapdict.New[string, int]()
+	d.Add("a", 1)
+	d.Add("b", 2)
+	d.Add("c", 3)
+	return d
+}
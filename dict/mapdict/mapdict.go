@@ -31,6 +31,16 @@ func (d *MapDict[K, V]) Add(k K, v V) {
 	d.values[k] = v
 }
 
+func (d *MapDict[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range d.values {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
 func (d *MapDict[K, V]) Contains(k K) bool {
 	_, ok := d.values[k]
 	return ok
@@ -0,0 +1,40 @@
+package mapdict
+
+import (
+	"github.com/craiggwilson/go-collections/dict"
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func Pivot[S any, R, C comparable](
+	src iter.Iterer[S],
+	rowKeySelector func(S) R,
+	columnKeySelector func(S) C,
+	accumulators ...iter.Accumulator[S],
+) (dict.Dict[R, dict.Dict[C, map[string]any]], error) {
+	aggregates := iter.AggregateBy(
+		src,
+		func(elem S) iter.KeyValuePair[R, C] {
+			return iter.KeyValuePair[R, C]{Key: rowKeySelector(elem), Value: columnKeySelector(elem)}
+		},
+		accumulators...,
+	)
+
+	rows := New[R, dict.Dict[C, map[string]any]]()
+
+	var err error
+	for agg := range iter.ToSeq(aggregates, &err) {
+		columns, ok := rows.Value(agg.Key.Key)
+		if !ok {
+			columns = New[C, map[string]any]()
+			rows.Add(agg.Key.Key, columns)
+		}
+
+		columns.Add(agg.Key.Value, agg.Values)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
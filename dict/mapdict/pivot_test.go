@@ -0,0 +1,92 @@
+package mapdict_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/dict"
+	"github.com/craiggwilson/go-collections/dict/mapdict"
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+var errBoom = errors.New("boom")
+
+type sale struct {
+	region  string
+	quarter int
+	amount  int
+}
+
+func TestPivot(t *testing.T) {
+	t.Parallel()
+
+	accumulators := []iter.Accumulator[sale]{
+		iter.AccumulateCount[sale]("count"),
+		iter.AccumulateSum("sum", func(s sale) int { return s.amount }),
+	}
+
+	testCases := []struct {
+		name     string
+		src      iter.Iterer[sale]
+		expected map[string]map[int]map[string]any
+		err      error
+	}{
+		{
+			name: "rows and columns",
+			src: iter.FromSlice([]sale{
+				{"east", 1, 10},
+				{"west", 1, 5},
+				{"east", 2, 30},
+				{"east", 1, 20},
+			}),
+			expected: map[string]map[int]map[string]any{
+				"east": {
+					1: {"count": 2, "sum": 30},
+					2: {"count": 1, "sum": 30},
+				},
+				"west": {
+					1: {"count": 1, "sum": 5},
+				},
+			},
+		},
+		{
+			name:     "empty",
+			src:      iter.FromSlice[sale](nil),
+			expected: map[string]map[int]map[string]any{},
+		},
+		{
+			name: "source error",
+			src:  iter.Concat(iter.FromSlice([]sale{{"east", 1, 10}}), iter.Err[sale](errBoom)),
+			err:  errBoom,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := mapdict.Pivot(tc.src, func(s sale) string { return s.region }, func(s sale) int { return s.quarter }, accumulators...)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+				must.Nil(t, actual)
+				return
+			}
+
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, toMaps(actual))
+		})
+	}
+}
+
+func toMaps(rows dict.Dict[string, dict.Dict[int, map[string]any]]) map[string]map[int]map[string]any {
+	result := make(map[string]map[int]map[string]any, rows.Len())
+	for row, columns := range rows.All() {
+		result[row] = make(map[int]map[string]any, columns.Len())
+		for column, values := range columns.All() {
+			result[row][column] = values
+		}
+	}
+
+	return result
+}
@@ -28,9 +28,19 @@ type SliceDict[K comparable, V any] struct {
 func (d *SliceDict[K, V]) Add(k K, v V) {
 	i := d.indexOf(k)
 	if i >= 0 {
-		d.pairs[i] = iter.KeyValuePair[K, V]{k, v}
+		d.pairs[i] = iter.KeyValuePair[K, V]{Key: k, Value: v}
 	} else {
-		d.pairs = append(d.pairs, iter.KeyValuePair[K, V]{k, v})
+		d.pairs = append(d.pairs, iter.KeyValuePair[K, V]{Key: k, Value: v})
+	}
+}
+
+func (d *SliceDict[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, pair := range d.pairs {
+			if !yield(pair.Key, pair.Value) {
+				return
+			}
+		}
 	}
 }
 
@@ -1,9 +1,10 @@
 module github.com/craiggwilson/go-collections
 
-go 1.18
+go 1.24
 
 require (
-	github.com/google/go-cmp v0.5.9 // indirect
-	github.com/shoenig/test v0.4.4 // indirect
-	golang.org/x/exp v0.0.0-20221111204811-129d8d6c17ab // indirect
+	github.com/shoenig/test v0.4.4
+	golang.org/x/exp v0.0.0-20221111204811-129d8d6c17ab
 )
+
+require github.com/google/go-cmp v0.5.9 // indirect
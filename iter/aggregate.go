@@ -0,0 +1,156 @@
+package iter
+
+import "golang.org/x/exp/constraints"
+
+type Accumulator[S any] struct {
+	Name string
+
+	seed   func() any
+	fold   func(any, S) any
+	result func(any) any
+}
+
+// Accumulate folds the elements of each group into a value of type A. seed is
+// called once per group, so reference types such as maps and slices are not
+// shared between groups.
+func Accumulate[S, A any](name string, seed func() A, fold func(A, S) A) Accumulator[S] {
+	return Accumulator[S]{
+		Name: name,
+		seed: func() any {
+			return seed()
+		},
+		fold: func(acc any, elem S) any {
+			return fold(acc.(A), elem)
+		},
+		result: func(acc any) any {
+			return acc
+		},
+	}
+}
+
+func AccumulateCount[S any](name string) Accumulator[S] {
+	return Accumulate(name, func() int { return 0 }, func(count int, _ S) int {
+		return count + 1
+	})
+}
+
+func AccumulateMax[S any, R constraints.Ordered](name string, selector func(S) R) Accumulator[S] {
+	return accumulateBest(name, selector, func(a, b R) bool { return a > b })
+}
+
+func AccumulateMin[S any, R constraints.Ordered](name string, selector func(S) R) Accumulator[S] {
+	return accumulateBest(name, selector, func(a, b R) bool { return a < b })
+}
+
+func AccumulateSum[S any, R constraints.Integer | constraints.Float](name string, selector func(S) R) Accumulator[S] {
+	return Accumulate(name, func() R { return 0 }, func(sum R, elem S) R {
+		return sum + selector(elem)
+	})
+}
+
+func accumulateBest[S any, R constraints.Ordered](name string, selector func(S) R, better func(R, R) bool) Accumulator[S] {
+	type best struct {
+		value R
+		ok    bool
+	}
+
+	return Accumulator[S]{
+		Name: name,
+		seed: func() any {
+			return best{}
+		},
+		fold: func(acc any, elem S) any {
+			b := acc.(best)
+			value := selector(elem)
+			if !b.ok || better(value, b.value) {
+				return best{value: value, ok: true}
+			}
+
+			return b
+		},
+		result: func(acc any) any {
+			return acc.(best).value
+		},
+	}
+}
+
+type Aggregate[K comparable] struct {
+	Key    K
+	Values map[string]any
+}
+
+func AggregateBy[S any, K comparable](src Iterer[S], keySelector func(S) K, accumulators ...Accumulator[S]) Iterer[Aggregate[K]] {
+	return ItererFunc[Aggregate[K]](func() Iter[Aggregate[K]] {
+		return &aggregateByIter[S, K]{
+			src:          src.Iter(),
+			keySelector:  keySelector,
+			accumulators: accumulators,
+		}
+	})
+}
+
+type aggregateByIter[S any, K comparable] struct {
+	closeGuard
+
+	src          Iter[S]
+	keySelector  func(S) K
+	accumulators []Accumulator[S]
+
+	keys   []K
+	states [][]any
+	built  bool
+	pos    int
+}
+
+func (it *aggregateByIter[S, K]) Next() (Aggregate[K], bool) {
+	if it.closed {
+		return Aggregate[K]{}, false
+	}
+
+	if !it.built {
+		it.built = true
+		it.build()
+	}
+
+	if it.pos >= len(it.keys) {
+		return Aggregate[K]{}, false
+	}
+
+	key, states := it.keys[it.pos], it.states[it.pos]
+	it.pos++
+
+	values := make(map[string]any, len(it.accumulators))
+	for i, acc := range it.accumulators {
+		values[acc.Name] = acc.result(states[i])
+	}
+
+	return Aggregate[K]{Key: key, Values: values}, true
+}
+
+func (it *aggregateByIter[S, K]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func (it *aggregateByIter[S, K]) build() {
+	indexes := make(map[K]int)
+	for elem, ok := it.src.Next(); ok; elem, ok = it.src.Next() {
+		key := it.keySelector(elem)
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(it.keys)
+			indexes[key] = idx
+			it.keys = append(it.keys, key)
+
+			states := make([]any, len(it.accumulators))
+			for i, acc := range it.accumulators {
+				states[i] = acc.seed()
+			}
+			it.states = append(it.states, states)
+		}
+
+		states := it.states[idx]
+		for i, acc := range it.accumulators {
+			states[i] = acc.fold(states[i], elem)
+		}
+	}
+}
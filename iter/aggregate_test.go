@@ -0,0 +1,80 @@
+package iter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+type sale struct {
+	region string
+	amount int
+}
+
+func TestAggregateBy(t *testing.T) {
+	t.Parallel()
+
+	sales := []sale{
+		{"east", 10},
+		{"west", 5},
+		{"east", 30},
+		{"east", 20},
+		{"west", 7},
+	}
+
+	accumulators := []iter.Accumulator[sale]{
+		iter.AccumulateCount[sale]("count"),
+		iter.AccumulateSum("sum", func(s sale) int { return s.amount }),
+		iter.AccumulateMin("min", func(s sale) int { return s.amount }),
+		iter.AccumulateMax("max", func(s sale) int { return s.amount }),
+		iter.Accumulate("regions", func() string { return "" }, func(acc string, s sale) string {
+			if acc != "" {
+				acc += ","
+			}
+			return acc + s.region[:1]
+		}),
+	}
+
+	t.Run("many", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.AggregateBy(iter.FromSlice(sales), func(s sale) string { return s.region }, accumulators...))
+		must.NoError(t, err)
+		must.Eq(t, []iter.Aggregate[string]{
+			{Key: "east", Values: map[string]any{"count": 3, "sum": 60, "min": 10, "max": 30, "regions": "e,e,e"}},
+			{Key: "west", Values: map[string]any{"count": 2, "sum": 12, "min": 5, "max": 7, "regions": "w,w"}},
+		}, actual)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.AggregateBy(iter.FromSlice[sale](nil), func(s sale) string { return s.region }, accumulators...))
+		must.NoError(t, err)
+		must.Nil(t, actual)
+	})
+
+	t.Run("reference seeds are per group", func(t *testing.T) {
+		amounts := iter.Accumulate("amounts", func() []int { return make([]int, 0, 8) }, func(acc []int, s sale) []int {
+			return append(acc, s.amount)
+		})
+		seen := iter.Accumulate("seen", func() map[int]bool { return map[int]bool{} }, func(acc map[int]bool, s sale) map[int]bool {
+			acc[s.amount] = true
+			return acc
+		})
+
+		actual, err := iter.ToSlice(iter.AggregateBy(iter.FromSlice(sales), func(s sale) string { return s.region }, amounts, seen))
+		must.NoError(t, err)
+		must.Eq(t, []iter.Aggregate[string]{
+			{Key: "east", Values: map[string]any{"amounts": []int{10, 30, 20}, "seen": map[int]bool{10: true, 30: true, 20: true}}},
+			{Key: "west", Values: map[string]any{"amounts": []int{5, 7}, "seen": map[int]bool{5: true, 7: true}}},
+		}, actual)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice(sales), iter.Err[sale](expectedErr))
+
+		_, err := iter.ToSlice(iter.AggregateBy(src, func(s sale) string { return s.region }, accumulators...))
+		must.ErrorIs(t, err, expectedErr)
+	})
+}
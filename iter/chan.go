@@ -0,0 +1,155 @@
+package iter
+
+import (
+	"context"
+	"fmt"
+)
+
+func FromChan[T any](ch <-chan T, opts ...ChanOpt) Iterer[T] {
+	var o chanOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return ItererFunc[T](func() Iter[T] {
+		return &chanIter[T]{
+			ch:    ch,
+			drain: o.drainOnClose,
+		}
+	})
+}
+
+type chanIter[T any] struct {
+	closeGuard
+
+	ch    <-chan T
+	drain bool
+}
+
+func (it *chanIter[T]) Next() (T, bool) {
+	if it.closed {
+		var def T
+		return def, false
+	}
+
+	value, ok := <-it.ch
+	return value, ok
+}
+
+func (it *chanIter[T]) Close() error {
+	return it.close(func() error {
+		if it.drain {
+			for range it.ch {
+			}
+		}
+
+		return nil
+	})
+}
+
+func Prefetch[T any](src Iterer[T], size int) Iterer[T] {
+	if size <= 0 {
+		panic("size must be greater than 0")
+	}
+
+	return ItererFunc[T](func() Iter[T] {
+		return &prefetchIter[T]{
+			src:  src.Iter(),
+			size: size,
+		}
+	})
+}
+
+type prefetchIter[T any] struct {
+	closeGuard
+
+	src  Iter[T]
+	size int
+
+	values   chan T
+	done     chan struct{}
+	finished chan struct{}
+	err      error
+
+	started bool
+}
+
+func (it *prefetchIter[T]) Next() (T, bool) {
+	if it.closed {
+		var def T
+		return def, false
+	}
+
+	if !it.started {
+		it.started = true
+		it.values = make(chan T, it.size)
+		it.done = make(chan struct{})
+		it.finished = make(chan struct{})
+		go it.fetch()
+	}
+
+	value, ok := <-it.values
+	return value, ok
+}
+
+func (it *prefetchIter[T]) Close() error {
+	return it.close(func() error {
+		if !it.started {
+			return it.src.Close()
+		}
+
+		close(it.done)
+		<-it.finished
+		return it.err
+	})
+}
+
+func (it *prefetchIter[T]) fetch() {
+	defer close(it.finished)
+	defer close(it.values)
+	defer func() {
+		var panicErr error
+		if r := recover(); r != nil {
+			panicErr = fmt.Errorf("%w: %v", ErrPanic, r)
+		}
+
+		it.err = combineErrors(panicErr, it.src.Close())
+	}()
+
+	for {
+		value, ok := it.src.Next()
+		if !ok {
+			return
+		}
+
+		select {
+		case it.values <- value:
+		case <-it.done:
+			return
+		}
+	}
+}
+
+func ToChan[T any](ctx context.Context, src Iterer[T]) (<-chan T, <-chan error) {
+	values := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(values)
+
+		it := src.Iter()
+		for value, ok := it.Next(); ok; value, ok = it.Next() {
+			select {
+			case values <- value:
+			case <-ctx.Done():
+				errs <- combineErrors(ctx.Err(), it.Close())
+				return
+			}
+		}
+
+		errs <- it.Close()
+	}()
+
+	return values, errs
+}
@@ -0,0 +1,119 @@
+package iter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestFromChan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 3
+		ch <- 5
+		close(ch)
+
+		actual, err := iter.ToSlice(iter.FromChan(ch))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+	})
+
+	t.Run("abandon on close", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 3
+		ch <- 5
+		close(ch)
+
+		actual, err := iter.ToSlice(iter.Take(iter.FromChan(ch), 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actual)
+		must.Eq(t, 2, len(ch))
+	})
+
+	t.Run("drain on close", func(t *testing.T) {
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 3
+		ch <- 5
+		close(ch)
+
+		actual, err := iter.ToSlice(iter.Take(iter.FromChan(ch, iter.WithDrainOnClose()), 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actual)
+		must.Eq(t, 0, len(ch))
+	})
+}
+
+func TestPrefetch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.Prefetch(iter.Range(0, 100, 1), 10))
+		must.NoError(t, err)
+		must.Eq(t, 100, len(actual))
+		must.Eq(t, 99, actual[99])
+	})
+
+	t.Run("early close", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.Take(iter.Prefetch(iter.Range(0, 100, 1), 10), 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{0, 1}, actual)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{1, 3}), iter.Err[int](expectedErr))
+
+		actual, err := iter.ToSlice(iter.Prefetch(src, 1))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, []int{1, 3}, actual)
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+		values, errs := iter.ToChan(context.Background(), iter.FromSlice([]int{1, 3, 5}))
+
+		var actual []int
+		for value := range values {
+			actual = append(actual, value)
+		}
+
+		must.NoError(t, <-errs)
+		must.Eq(t, []int{1, 3, 5}, actual)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{1, 3}), iter.Err[int](expectedErr))
+		values, errs := iter.ToChan(context.Background(), src)
+
+		var actual []int
+		for value := range values {
+			actual = append(actual, value)
+		}
+
+		must.ErrorIs(t, <-errs, expectedErr)
+		must.Eq(t, []int{1, 3}, actual)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		values, errs := iter.ToChan(ctx, iter.Repeat(1, 100))
+
+		<-values
+		cancel()
+
+		must.ErrorIs(t, <-errs, context.Canceled)
+	})
+}
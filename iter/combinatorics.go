@@ -0,0 +1,379 @@
+package iter
+
+func CartesianProduct[S any](srcs ...Iterer[S]) Iterer[[]S] {
+	return newCombinatorics(srcs, func() enumerator { return &productEnumerator{} }, func(sizes []int) int {
+		count := 1
+		for _, size := range sizes {
+			count *= size
+		}
+
+		return count
+	})
+}
+
+func Combinations[S any](src Iterer[S], k int) Iterer[[]S] {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+
+	return newCombinatorics([]Iterer[S]{src}, func() enumerator { return &combinationEnumerator{k: k} }, func(sizes []int) int {
+		return binomial(sizes[0], k)
+	})
+}
+
+func CombinationsWithReplacement[S any](src Iterer[S], k int) Iterer[[]S] {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+
+	return newCombinatorics([]Iterer[S]{src}, func() enumerator { return &replacementEnumerator{k: k} }, func(sizes []int) int {
+		if sizes[0] == 0 {
+			return binomial(0, k)
+		}
+
+		return binomial(sizes[0]+k-1, k)
+	})
+}
+
+func Permutations[S any](src Iterer[S], k int) Iterer[[]S] {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+
+	return newCombinatorics([]Iterer[S]{src}, func() enumerator { return &permutationEnumerator{k: k} }, func(sizes []int) int {
+		n := sizes[0]
+		if k > n {
+			return 0
+		}
+
+		count := 1
+		for i := n - k + 1; i <= n; i++ {
+			count *= i
+		}
+
+		return count
+	})
+}
+
+func PowerSet[S any](src Iterer[S]) Iterer[[]S] {
+	return newCombinatorics([]Iterer[S]{src}, func() enumerator { return &powerSetEnumerator{} }, func(sizes []int) int {
+		return 1 << sizes[0]
+	})
+}
+
+func binomial(n, k int) int {
+	if k > n {
+		return 0
+	}
+
+	k = min(k, n-k)
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+	}
+
+	return result
+}
+
+func newCombinatorics[S any](srcs []Iterer[S], newEnumerator func() enumerator, count func(sizes []int) int) Iterer[[]S] {
+	itr := &combinatoricsIterer[S]{
+		srcs:          srcs,
+		newEnumerator: newEnumerator,
+	}
+
+	sizes := make([]int, len(srcs))
+	for i, src := range srcs {
+		switch sized := src.(type) {
+		case interface{ Len() int }:
+			sizes[i] = sized.Len()
+		case interface{ ToSlice() []S }:
+			sizes[i] = len(sized.ToSlice())
+		default:
+			return itr
+		}
+	}
+
+	return &sizedCombinatoricsIterer[S]{
+		combinatoricsIterer: itr,
+		count:               count(sizes),
+	}
+}
+
+type combinatoricsIterer[S any] struct {
+	srcs          []Iterer[S]
+	newEnumerator func() enumerator
+}
+
+func (itr *combinatoricsIterer[S]) Iter() Iter[[]S] {
+	return &combinatoricsIter[S]{
+		srcs:       iterAll(itr.srcs),
+		enumerator: itr.newEnumerator(),
+	}
+}
+
+type sizedCombinatoricsIterer[S any] struct {
+	*combinatoricsIterer[S]
+
+	count int
+}
+
+func (itr *sizedCombinatoricsIterer[S]) Len() int {
+	return itr.count
+}
+
+// enumerator walks the index vectors of a combinatoric sequence. Each position
+// indexes into the pool of the same position, or into the only pool when there
+// is just one.
+type enumerator interface {
+	start(sizes []int) bool
+	advance() bool
+	indices() []int
+}
+
+type combinatoricsIter[S any] struct {
+	closeGuard
+
+	srcs       []Iter[S]
+	enumerator enumerator
+
+	pools   [][]S
+	started bool
+	done    bool
+}
+
+func (it *combinatoricsIter[S]) Next() ([]S, bool) {
+	if it.closed || it.done {
+		return nil, false
+	}
+
+	var ok bool
+	if !it.started {
+		it.started = true
+		ok = it.enumerator.start(it.fill())
+	} else {
+		ok = it.enumerator.advance()
+	}
+
+	if !ok {
+		it.done = true
+		return nil, false
+	}
+
+	indices := it.enumerator.indices()
+	result := make([]S, len(indices))
+	for i, idx := range indices {
+		pool := it.pools[0]
+		if len(it.pools) > 1 {
+			pool = it.pools[i]
+		}
+
+		result[i] = pool[idx]
+	}
+
+	return result, true
+}
+
+func (it *combinatoricsIter[S]) Close() error {
+	return it.close(func() error {
+		return closeAll(it.srcs)
+	})
+}
+
+func (it *combinatoricsIter[S]) fill() []int {
+	it.pools = make([][]S, len(it.srcs))
+	sizes := make([]int, len(it.srcs))
+	for i, src := range it.srcs {
+		for elem, ok := src.Next(); ok; elem, ok = src.Next() {
+			it.pools[i] = append(it.pools[i], elem)
+		}
+
+		sizes[i] = len(it.pools[i])
+	}
+
+	return sizes
+}
+
+type combinationEnumerator struct {
+	k   int
+	n   int
+	idx []int
+}
+
+func (e *combinationEnumerator) start(sizes []int) bool {
+	e.n = sizes[0]
+	if e.k > e.n {
+		return false
+	}
+
+	e.idx = make([]int, e.k)
+	for i := range e.idx {
+		e.idx[i] = i
+	}
+
+	return true
+}
+
+func (e *combinationEnumerator) advance() bool {
+	i := e.k - 1
+	for i >= 0 && e.idx[i] == i+e.n-e.k {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	e.idx[i]++
+	for j := i + 1; j < e.k; j++ {
+		e.idx[j] = e.idx[j-1] + 1
+	}
+
+	return true
+}
+
+func (e *combinationEnumerator) indices() []int {
+	return e.idx
+}
+
+type permutationEnumerator struct {
+	k      int
+	n      int
+	idx    []int
+	cycles []int
+}
+
+func (e *permutationEnumerator) start(sizes []int) bool {
+	e.n = sizes[0]
+	if e.k > e.n {
+		return false
+	}
+
+	e.idx = make([]int, e.n)
+	for i := range e.idx {
+		e.idx[i] = i
+	}
+
+	e.cycles = make([]int, e.k)
+	for i := range e.cycles {
+		e.cycles[i] = e.n - i
+	}
+
+	return true
+}
+
+func (e *permutationEnumerator) advance() bool {
+	for i := e.k - 1; i >= 0; i-- {
+		e.cycles[i]--
+		if e.cycles[i] == 0 {
+			first := e.idx[i]
+			copy(e.idx[i:], e.idx[i+1:])
+			e.idx[e.n-1] = first
+			e.cycles[i] = e.n - i
+			continue
+		}
+
+		j := e.n - e.cycles[i]
+		e.idx[i], e.idx[j] = e.idx[j], e.idx[i]
+		return true
+	}
+
+	return false
+}
+
+func (e *permutationEnumerator) indices() []int {
+	return e.idx[:e.k]
+}
+
+type powerSetEnumerator struct {
+	combination combinationEnumerator
+}
+
+func (e *powerSetEnumerator) start(sizes []int) bool {
+	e.combination = combinationEnumerator{}
+	return e.combination.start(sizes)
+}
+
+func (e *powerSetEnumerator) advance() bool {
+	if e.combination.advance() {
+		return true
+	}
+
+	e.combination.k++
+	return e.combination.start([]int{e.combination.n})
+}
+
+func (e *powerSetEnumerator) indices() []int {
+	return e.combination.indices()
+}
+
+type productEnumerator struct {
+	sizes []int
+	idx   []int
+}
+
+func (e *productEnumerator) start(sizes []int) bool {
+	for _, size := range sizes {
+		if size == 0 {
+			return false
+		}
+	}
+
+	e.sizes = sizes
+	e.idx = make([]int, len(sizes))
+	return true
+}
+
+func (e *productEnumerator) advance() bool {
+	for i := len(e.idx) - 1; i >= 0; i-- {
+		e.idx[i]++
+		if e.idx[i] < e.sizes[i] {
+			return true
+		}
+
+		e.idx[i] = 0
+	}
+
+	return false
+}
+
+func (e *productEnumerator) indices() []int {
+	return e.idx
+}
+
+type replacementEnumerator struct {
+	k   int
+	n   int
+	idx []int
+}
+
+func (e *replacementEnumerator) start(sizes []int) bool {
+	e.n = sizes[0]
+	if e.n == 0 && e.k > 0 {
+		return false
+	}
+
+	e.idx = make([]int, e.k)
+	return true
+}
+
+func (e *replacementEnumerator) advance() bool {
+	i := e.k - 1
+	for i >= 0 && e.idx[i] == e.n-1 {
+		i--
+	}
+
+	if i < 0 {
+		return false
+	}
+
+	next := e.idx[i] + 1
+	for j := i; j < e.k; j++ {
+		e.idx[j] = next
+	}
+
+	return true
+}
+
+func (e *replacementEnumerator) indices() []int {
+	return e.idx
+}
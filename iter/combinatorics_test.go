@@ -0,0 +1,235 @@
+package iter_test
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestCartesianProduct(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		srcs     [][]int
+		expected [][]int
+	}{
+		{
+			name:     "two",
+			srcs:     [][]int{{1, 2}, {3, 4, 5}},
+			expected: [][]int{{1, 3}, {1, 4}, {1, 5}, {2, 3}, {2, 4}, {2, 5}},
+		},
+		{
+			name:     "one",
+			srcs:     [][]int{{1, 2}},
+			expected: [][]int{{1}, {2}},
+		},
+		{
+			name:     "one empty",
+			srcs:     [][]int{{1, 2}, nil},
+			expected: nil,
+		},
+		{
+			name:     "no sources",
+			srcs:     nil,
+			expected: [][]int{{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			srcs := make([]iter.Iterer[int], len(tc.srcs))
+			for i, src := range tc.srcs {
+				srcs[i] = iter.FromSlice(src)
+			}
+
+			product := iter.CartesianProduct(srcs...)
+
+			actual, err := iter.ToSlice(product)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(product)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      []int
+		k        int
+		expected [][]int
+	}{
+		{
+			name:     "two of four",
+			src:      []int{1, 2, 3, 4},
+			k:        2,
+			expected: [][]int{{1, 2}, {1, 3}, {1, 4}, {2, 3}, {2, 4}, {3, 4}},
+		},
+		{
+			name:     "all",
+			src:      []int{1, 2, 3},
+			k:        3,
+			expected: [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "zero",
+			src:      []int{1, 2, 3},
+			k:        0,
+			expected: [][]int{{}},
+		},
+		{
+			name:     "too many",
+			src:      []int{1, 2},
+			k:        3,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			combinations := iter.Combinations(iter.FromSlice(tc.src), tc.k)
+
+			actual, err := iter.ToSlice(combinations)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(combinations)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+
+	t.Run("unsized source", func(t *testing.T) {
+		src := iter.Filter(iter.FromSlice([]int{1, 2, 3}), func(int) bool { return true })
+
+		length, err := iter.Len(iter.Combinations(src, 2))
+		must.NoError(t, err)
+		must.Eq(t, 3, length)
+	})
+
+	t.Run("does not alias", func(t *testing.T) {
+		it := iter.Combinations(iter.FromSlice([]int{1, 2, 3}), 2).Iter()
+		first, _ := it.Next()
+		_, _ = it.Next()
+		must.Eq(t, []int{1, 2}, first)
+		must.NoError(t, it.Close())
+	})
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      []int
+		k        int
+		expected [][]int
+	}{
+		{
+			name:     "two of three",
+			src:      []int{1, 2, 3},
+			k:        2,
+			expected: [][]int{{1, 1}, {1, 2}, {1, 3}, {2, 2}, {2, 3}, {3, 3}},
+		},
+		{
+			name:     "more than source",
+			src:      []int{1, 2},
+			k:        3,
+			expected: [][]int{{1, 1, 1}, {1, 1, 2}, {1, 2, 2}, {2, 2, 2}},
+		},
+		{
+			name:     "empty source",
+			src:      nil,
+			k:        2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			combinations := iter.CombinationsWithReplacement(iter.FromSlice(tc.src), tc.k)
+
+			actual, err := iter.ToSlice(combinations)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(combinations)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+}
+
+func TestPermutations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      []int
+		k        int
+		expected [][]int
+	}{
+		{
+			name:     "all of three",
+			src:      []int{1, 2, 3},
+			k:        3,
+			expected: [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}},
+		},
+		{
+			name:     "two of three",
+			src:      []int{1, 2, 3},
+			k:        2,
+			expected: [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}},
+		},
+		{
+			name:     "too many",
+			src:      []int{1, 2},
+			k:        3,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			permutations := iter.Permutations(iter.FromSlice(tc.src), tc.k)
+
+			actual, err := iter.ToSlice(permutations)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(permutations)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+}
+
+func TestPowerSet(t *testing.T) {
+	t.Parallel()
+
+	powerSet := iter.PowerSet(iter.FromSlice([]string{"a", "b", "c"}))
+
+	actual, err := iter.ToSlice(powerSet)
+	must.NoError(t, err)
+	must.Eq(t, [][]string{{}, {"a"}, {"b"}, {"c"}, {"a", "b"}, {"a", "c"}, {"b", "c"}, {"a", "b", "c"}}, actual)
+
+	length, err := iter.Len(powerSet)
+	must.NoError(t, err)
+	must.Eq(t, 8, length)
+
+	actual, err = iter.ToSlice(iter.PowerSet(iter.FromSlice[string](nil)))
+	must.NoError(t, err)
+	must.Eq(t, [][]string{{}}, actual)
+}
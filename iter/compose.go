@@ -1,7 +1,20 @@
 package iter
 
+import "sync"
+
 func Chunk[S any](src Iterer[S], size int) Iterer[[]S] {
-	panic("not implemented")
+	if size <= 0 {
+		panic("size must be greater than 0")
+	}
+
+	return ItererFunc[[]S](func() Iter[[]S] {
+		return &windowIter[S]{
+			src:     src.Iter(),
+			size:    size,
+			step:    size,
+			partial: true,
+		}
+	})
 }
 
 func Concat[S any](first Iterer[S], second Iterer[S]) Iterer[S] {
@@ -14,6 +27,8 @@ func Concat[S any](first Iterer[S], second Iterer[S]) Iterer[S] {
 }
 
 type concatIter[S any] struct {
+	closeGuard
+
 	first  Iter[S]
 	second Iter[S]
 
@@ -21,6 +36,11 @@ type concatIter[S any] struct {
 }
 
 func (it *concatIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
 	if !it.firstDone {
 		value, ok := it.first.Next()
 		if ok {
@@ -34,14 +54,9 @@ func (it *concatIter[S]) Next() (S, bool) {
 }
 
 func (it *concatIter[S]) Close() error {
-	err1 := it.first.Close()
-	err2 := it.second.Close()
-
-	if err1 != nil {
-		return err1
-	}
-
-	return err2
+	return it.close(func() error {
+		return combineErrors(it.first.Close(), it.second.Close())
+	})
 }
 
 func Distinct[S comparable](src Iterer[S]) Iterer[S] {
@@ -54,11 +69,18 @@ func Distinct[S comparable](src Iterer[S]) Iterer[S] {
 }
 
 type distinctIter[S comparable] struct {
+	closeGuard
+
 	src Iter[S]
 	set map[S]struct{}
 }
 
 func (it *distinctIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
 	for {
 		elem, ok := it.src.Next()
 		if !ok {
@@ -73,7 +95,82 @@ func (it *distinctIter[S]) Next() (S, bool) {
 }
 
 func (it *distinctIter[S]) Close() error {
-	return it.src.Close()
+	return it.close(it.src.Close)
+}
+
+func DistinctBy[S any, K comparable](src Iterer[S], keySelector func(S) K) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &distinctByIter[S, K]{
+			src:         src.Iter(),
+			keySelector: keySelector,
+			set:         make(map[K]struct{}),
+		}
+	})
+}
+
+type distinctByIter[S any, K comparable] struct {
+	closeGuard
+
+	src         Iter[S]
+	keySelector func(S) K
+	set         map[K]struct{}
+}
+
+func (it *distinctByIter[S, K]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	for {
+		elem, ok := it.src.Next()
+		if !ok {
+			return elem, false
+		}
+
+		key := it.keySelector(elem)
+		if _, ok = it.set[key]; !ok {
+			it.set[key] = struct{}{}
+			return elem, true
+		}
+	}
+}
+
+func (it *distinctByIter[S, K]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func Enumerate[S any](src Iterer[S]) Iterer[KeyValuePair[int, S]] {
+	return ItererFunc[KeyValuePair[int, S]](func() Iter[KeyValuePair[int, S]] {
+		return &enumerateIter[S]{
+			src: src.Iter(),
+		}
+	})
+}
+
+type enumerateIter[S any] struct {
+	closeGuard
+
+	src Iter[S]
+	idx int
+}
+
+func (it *enumerateIter[S]) Next() (KeyValuePair[int, S], bool) {
+	if it.closed {
+		return KeyValuePair[int, S]{}, false
+	}
+
+	value, ok := it.src.Next()
+	if !ok {
+		return KeyValuePair[int, S]{}, false
+	}
+
+	it.idx++
+	return KeyValuePair[int, S]{Key: it.idx - 1, Value: value}, true
+}
+
+func (it *enumerateIter[S]) Close() error {
+	return it.close(it.src.Close)
 }
 
 func Filter[S any](src Iterer[S], filter func(S) bool) Iterer[S] {
@@ -86,11 +183,18 @@ func Filter[S any](src Iterer[S], filter func(S) bool) Iterer[S] {
 }
 
 type filterIter[S any] struct {
+	closeGuard
+
 	src    Iter[S]
 	filter func(S) bool
 }
 
 func (it *filterIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
 	for {
 		elem, ok := it.src.Next()
 		if !ok {
@@ -104,7 +208,15 @@ func (it *filterIter[S]) Next() (S, bool) {
 }
 
 func (it *filterIter[S]) Close() error {
-	return it.src.Close()
+	return it.close(it.src.Close)
+}
+
+func Flatten[S any](src Iterer[Iterer[S]]) Iterer[S] {
+	return SelectMany(src, identity[Iterer[S]])
+}
+
+func FlattenSlices[S any](src Iterer[[]S]) Iterer[S] {
+	return SelectMany(src, FromSlice[S])
 }
 
 type Grouping[S any, K comparable] struct {
@@ -114,232 +226,1093 @@ type Grouping[S any, K comparable] struct {
 
 func Group[S any, K comparable](src Iterer[S], keySelector func(S) K) Iterer[Grouping[S, K]] {
 	return ItererFunc[Grouping[S, K]](func() Iter[Grouping[S, K]] {
-		result, err := buildGroup(src, keySelector)
-		if err != nil {
-			return FromSlice[Grouping[S, K]](result).Iter()
+		return &groupIter[S, K]{
+			src:         src.Iter(),
+			keySelector: keySelector,
 		}
-
-		return Concat(
-			FromSlice[Grouping[S, K]](result),
-			Err[Grouping[S, K]](err),
-		).Iter()
 	})
 }
 
-func buildGroup[S any, K comparable](src Iterer[S], keySelector func(S) K) (result []Grouping[S, K], err error) {
-	it := src.Iter()
-	defer func() {
-		err = it.Close()
-	}()
+type groupIter[S any, K comparable] struct {
+	closeGuard
+
+	src         Iter[S]
+	keySelector func(S) K
 
-	m := make(map[K][]S)
+	groups []Grouping[S, K]
+	built  bool
+	pos    int
+}
 
-	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
-		key := keySelector(elem)
-		m[key] = append(m[key], elem)
+func (it *groupIter[S, K]) Next() (Grouping[S, K], bool) {
+	if it.closed {
+		return Grouping[S, K]{}, false
 	}
 
-	result = make([]Grouping[S, K], len(m))
-	for k, v := range m {
-		result = append(result, Grouping[S, K]{
-			Key:    k,
-			Values: v,
-		})
+	if !it.built {
+		it.built = true
+		it.build()
 	}
 
-	return
-}
+	if it.pos < len(it.groups) {
+		it.pos++
+		return it.groups[it.pos-1], true
+	}
 
-func Select[S, R any](src Iterer[S], selector func(S) R) Iterer[R] {
-	return ItererFunc[R](func() Iter[R] {
-		return &selectIter[S, R]{
-			src:      src.Iter(),
-			selector: selector,
-		}
-	})
+	return Grouping[S, K]{}, false
 }
 
-type selectIter[S, R any] struct {
-	src      Iter[S]
-	selector func(S) R
+func (it *groupIter[S, K]) Close() error {
+	return it.close(it.src.Close)
 }
 
-func (it *selectIter[S, R]) Next() (R, bool) {
-	value, ok := it.src.Next()
-	if !ok {
-		var def R
-		return def, false
-	}
-
-	return it.selector(value), true
-}
+func (it *groupIter[S, K]) build() {
+	indexes := make(map[K]int)
+	for elem, ok := it.src.Next(); ok; elem, ok = it.src.Next() {
+		key := it.keySelector(elem)
+		idx, ok := indexes[key]
+		if !ok {
+			idx = len(it.groups)
+			indexes[key] = idx
+			it.groups = append(it.groups, Grouping[S, K]{Key: key})
+		}
 
-func (it *selectIter[S, R]) Close() error {
-	return it.src.Close()
+		it.groups[idx].Values = append(it.groups[idx].Values, elem)
+	}
 }
 
-func SelectMany[S, R any](src Iterer[S], selector func(S) Iterer[R]) Iterer[R] {
-	return ItererFunc[R](func() Iter[R] {
-		return &selectManyIter[S, R]{
-			src:      src.Iter(),
-			selector: selector,
+func GroupAdjacent[S any, K comparable](src Iterer[S], keySelector func(S) K) Iterer[Grouping[S, K]] {
+	return ItererFunc[Grouping[S, K]](func() Iter[Grouping[S, K]] {
+		return &groupAdjacentIter[S, K]{
+			src:         src.Iter(),
+			keySelector: keySelector,
 		}
 	})
 }
 
-type selectManyIter[S, R any] struct {
-	src      Iter[S]
-	selector func(S) Iterer[R]
+type groupAdjacentIter[S any, K comparable] struct {
+	closeGuard
 
-	cur Iter[R]
-	err error
+	src         Iter[S]
+	keySelector func(S) K
+
+	next    S
+	nextKey K
+	hasNext bool
+	started bool
 }
 
-func (it *selectManyIter[S, R]) Next() (R, bool) {
-	if it.err != nil {
-		var def R
-		return def, false
+func (it *groupAdjacentIter[S, K]) Next() (Grouping[S, K], bool) {
+	if it.closed {
+		return Grouping[S, K]{}, false
 	}
 
-	for {
-		if it.cur != nil {
-			elem, ok := it.cur.Next()
-			if ok {
-				return elem, true
-			}
-
-			it.err = it.cur.Close()
-			if it.err != nil {
-				var def R
-				return def, false
-			}
+	if !it.started {
+		it.started = true
+		it.advance()
+	}
 
-			it.cur = nil
-		}
+	if !it.hasNext {
+		return Grouping[S, K]{}, false
+	}
 
-		next, ok := it.src.Next()
-		if !ok {
-			var def R
-			return def, false
-		}
+	group := Grouping[S, K]{
+		Key:    it.nextKey,
+		Values: []S{it.next},
+	}
 
-		nextIterer := it.selector(next)
-		if nextIterer != nil {
-			it.cur = nextIterer.Iter()
-		}
+	for it.advance() && it.nextKey == group.Key {
+		group.Values = append(group.Values, it.next)
 	}
 
-	var def R
-	return def, false
+	return group, true
 }
 
-func (it *selectManyIter[S, R]) Close() error {
-	srcErr := it.src.Close()
-	if it.err != nil {
-		return it.err
+func (it *groupAdjacentIter[S, K]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func (it *groupAdjacentIter[S, K]) advance() bool {
+	it.next, it.hasNext = it.src.Next()
+	if it.hasNext {
+		it.nextKey = it.keySelector(it.next)
 	}
 
-	return srcErr
+	return it.hasNext
 }
 
-func Skip[S any](src Iterer[S], skip int) Iterer[S] {
+func Interleave[S any](srcs ...Iterer[S]) Iterer[S] {
 	return ItererFunc[S](func() Iter[S] {
-		return &skipIter[S]{
-			src:  src.Iter(),
-			skip: skip,
+		return &interleaveIter[S]{
+			srcs: iterAll(srcs),
 		}
 	})
 }
 
-type skipIter[S any] struct {
-	src  Iter[S]
-	skip int
+type interleaveIter[S any] struct {
+	closeGuard
 
-	count int
+	srcs []Iter[S]
+
+	round []S
+	pos   int
+	done  bool
 }
 
-func (it *skipIter[S]) Next() (S, bool) {
-	for it.count < it.skip {
-		it.count++
-		value, ok := it.src.Next()
-		if !ok {
-			return value, false
+func (it *interleaveIter[S]) Next() (S, bool) {
+	if it.closed || it.done {
+		var def S
+		return def, false
+	}
+
+	if it.pos >= len(it.round) {
+		if !it.fill() {
+			it.done = true
+			var def S
+			return def, false
 		}
 	}
 
-	return it.src.Next()
+	value := it.round[it.pos]
+	it.pos++
+	return value, true
 }
 
-func (it *skipIter[S]) Close() error {
-	return it.src.Close()
+func (it *interleaveIter[S]) Close() error {
+	return it.close(func() error {
+		return closeAll(it.srcs)
+	})
 }
 
-func Take[S any](src Iterer[S], limit int) Iterer[S] {
+func (it *interleaveIter[S]) fill() bool {
+	if len(it.srcs) == 0 {
+		return false
+	}
+
+	if it.round == nil {
+		it.round = make([]S, len(it.srcs))
+	}
+
+	for i, src := range it.srcs {
+		value, ok := src.Next()
+		if !ok {
+			return false
+		}
+
+		it.round[i] = value
+	}
+
+	it.pos = 0
+	return true
+}
+
+func Intersperse[S any](src Iterer[S], sep S) Iterer[S] {
 	return ItererFunc[S](func() Iter[S] {
-		return &takeIter[S]{
-			src:   src.Iter(),
-			limit: limit,
+		return &intersperseIter[S]{
+			src: src.Iter(),
+			sep: sep,
 		}
 	})
 }
 
-type takeIter[S any] struct {
-	src   Iter[S]
-	limit int
+type intersperseIter[S any] struct {
+	closeGuard
 
-	count int
+	src Iter[S]
+	sep S
+
+	next    S
+	hasNext bool
+	started bool
 }
 
-func (it *takeIter[S]) Next() (S, bool) {
-	if it.count >= it.limit {
+func (it *intersperseIter[S]) Next() (S, bool) {
+	if it.closed {
 		var def S
 		return def, false
 	}
 
-	it.count++
-	return it.src.Next()
+	if !it.started {
+		it.started = true
+		return it.src.Next()
+	}
+
+	if it.hasNext {
+		it.hasNext = false
+		value := it.next
+		var def S
+		it.next = def
+		return value, true
+	}
+
+	value, ok := it.src.Next()
+	if !ok {
+		return value, false
+	}
+
+	it.next = value
+	it.hasNext = true
+	return it.sep, true
 }
 
-func (it *takeIter[S]) Close() error {
-	return it.src.Close()
+func (it *intersperseIter[S]) Close() error {
+	return it.close(it.src.Close)
 }
 
-func Zip[S1, S2, R any](first Iterer[S1], second Iterer[S2], zipper func(S1, S2) R) Iterer[R] {
+func Pairwise[S, R any](src Iterer[S], zipper func(S, S) R) Iterer[R] {
 	return ItererFunc[R](func() Iter[R] {
-		return &zipIter[S1, S2, R]{
-			first:  first.Iter(),
-			second: second.Iter(),
+		return &pairwiseIter[S, R]{
+			src:    src.Iter(),
 			zipper: zipper,
 		}
 	})
 }
 
-type zipIter[S1, S2, R any] struct {
-	first  Iter[S1]
-	second Iter[S2]
+type pairwiseIter[S, R any] struct {
+	closeGuard
 
-	zipper func(S1, S2) R
+	src    Iter[S]
+	zipper func(S, S) R
+
+	prev    S
+	started bool
 }
 
-func (it *zipIter[S1, S2, R]) Next() (R, bool) {
-	value1, ok1 := it.first.Next()
-	value2, ok2 := it.second.Next()
+func (it *pairwiseIter[S, R]) Next() (R, bool) {
+	if it.closed {
+		var def R
+		return def, false
+	}
 
-	if ok1 && ok2 {
-		return it.zipper(value1, value2), true
+	if !it.started {
+		it.started = true
+		prev, ok := it.src.Next()
+		if !ok {
+			var def R
+			return def, false
+		}
+
+		it.prev = prev
 	}
 
-	var def R
-	return def, false
+	value, ok := it.src.Next()
+	if !ok {
+		var def R
+		return def, false
+	}
+
+	prev := it.prev
+	it.prev = value
+	return it.zipper(prev, value), true
 }
 
-func (it *zipIter[S1, S2, R]) Close() error {
-	err1 := it.first.Close()
-	err2 := it.second.Close()
+func (it *pairwiseIter[S, R]) Close() error {
+	return it.close(it.src.Close)
+}
 
-	if err1 != nil {
-		return err1
+// Partition splits src into the elements that match predicate and those that do
+// not, reading src once for both. Elements for a side that has not called Iter
+// yet are buffered for it. The source is closed when it is exhausted or once
+// both sides have closed.
+func Partition[S any](src Iterer[S], predicate func(S) bool) (Iterer[S], Iterer[S]) {
+	p := &partitioner[S]{
+		src:       src,
+		predicate: predicate,
 	}
 
-	return err2
+	return p.side(0), p.side(1)
+}
+
+type partitioner[S any] struct {
+	src       Iterer[S]
+	predicate func(S) bool
+
+	mu   sync.Mutex
+	pass *partitionPass[S]
+}
+
+func (p *partitioner[S]) side(side int) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		if p.pass == nil || !p.pass.join(side) {
+			p.pass = &partitionPass[S]{
+				src:       p.src.Iter(),
+				predicate: p.predicate,
+			}
+			p.pass.join(side)
+		}
+
+		return &partitionIter[S]{
+			pass: p.pass,
+			side: side,
+		}
+	})
+}
+
+type partitionPass[S any] struct {
+	src       Iter[S]
+	predicate func(S) bool
+
+	mu        sync.Mutex
+	joined    [2]bool
+	closed    [2]bool
+	buffers   [2][]S
+	srcClosed bool
+	err       error
+}
+
+func (p *partitionPass[S]) join(side int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.joined[side] {
+		return false
+	}
+
+	p.joined[side] = true
+	return true
+}
+
+func (p *partitionPass[S]) next(side int) (S, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.buffers[side]) > 0 {
+		value := p.buffers[side][0]
+		p.buffers[side] = p.buffers[side][1:]
+		return value, true
+	}
+
+	for !p.srcClosed {
+		value, ok := p.src.Next()
+		if !ok {
+			p.closeSrc()
+			break
+		}
+
+		target := 1
+		if p.predicate(value) {
+			target = 0
+		}
+
+		if target == side {
+			return value, true
+		}
+
+		if !p.closed[target] {
+			p.buffers[target] = append(p.buffers[target], value)
+		}
+	}
+
+	var def S
+	return def, false
+}
+
+func (p *partitionPass[S]) closeSide(side int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed[side] = true
+	p.buffers[side] = nil
+	if p.closed[0] && p.closed[1] {
+		p.closeSrc()
+	}
+
+	return p.err
+}
+
+func (p *partitionPass[S]) closeSrc() {
+	if !p.srcClosed {
+		p.srcClosed = true
+		p.err = p.src.Close()
+	}
+}
+
+type partitionIter[S any] struct {
+	closeGuard
+
+	pass *partitionPass[S]
+	side int
+}
+
+func (it *partitionIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	return it.pass.next(it.side)
+}
+
+func (it *partitionIter[S]) Close() error {
+	return it.close(func() error {
+		return it.pass.closeSide(it.side)
+	})
+}
+
+func RoundRobin[S any](srcs ...Iterer[S]) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &roundRobinIter[S]{
+			srcs:      iterAll(srcs),
+			exhausted: make([]bool, len(srcs)),
+			remaining: len(srcs),
+		}
+	})
+}
+
+type roundRobinIter[S any] struct {
+	closeGuard
+
+	srcs      []Iter[S]
+	exhausted []bool
+	remaining int
+	pos       int
+}
+
+func (it *roundRobinIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	for it.remaining > 0 {
+		idx := it.pos
+		it.pos = (it.pos + 1) % len(it.srcs)
+		if it.exhausted[idx] {
+			continue
+		}
+
+		value, ok := it.srcs[idx].Next()
+		if ok {
+			return value, true
+		}
+
+		it.exhausted[idx] = true
+		it.remaining--
+	}
+
+	var def S
+	return def, false
+}
+
+func (it *roundRobinIter[S]) Close() error {
+	return it.close(func() error {
+		return closeAll(it.srcs)
+	})
+}
+
+func Select[S, R any](src Iterer[S], selector func(S) R) Iterer[R] {
+	return ItererFunc[R](func() Iter[R] {
+		return &selectIter[S, R]{
+			src:      src.Iter(),
+			selector: selector,
+		}
+	})
+}
+
+type selectIter[S, R any] struct {
+	closeGuard
+
+	src      Iter[S]
+	selector func(S) R
+}
+
+func (it *selectIter[S, R]) Next() (R, bool) {
+	if it.closed {
+		var def R
+		return def, false
+	}
+
+	value, ok := it.src.Next()
+	if !ok {
+		var def R
+		return def, false
+	}
+
+	return it.selector(value), true
+}
+
+func (it *selectIter[S, R]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func SelectMany[S, R any](src Iterer[S], selector func(S) Iterer[R]) Iterer[R] {
+	return ItererFunc[R](func() Iter[R] {
+		return &selectManyIter[S, R]{
+			src:      src.Iter(),
+			selector: selector,
+		}
+	})
+}
+
+type selectManyIter[S, R any] struct {
+	closeGuard
+
+	src      Iter[S]
+	selector func(S) Iterer[R]
+
+	cur Iter[R]
+	err error
+}
+
+func (it *selectManyIter[S, R]) Next() (R, bool) {
+	if it.closed || it.err != nil {
+		var def R
+		return def, false
+	}
+
+	for {
+		if it.cur != nil {
+			elem, ok := it.cur.Next()
+			if ok {
+				return elem, true
+			}
+
+			it.err = it.cur.Close()
+			it.cur = nil
+			if it.err != nil {
+				var def R
+				return def, false
+			}
+		}
+
+		next, ok := it.src.Next()
+		if !ok {
+			var def R
+			return def, false
+		}
+
+		nextIterer := it.selector(next)
+		if nextIterer != nil {
+			it.cur = nextIterer.Iter()
+		}
+	}
+}
+
+func (it *selectManyIter[S, R]) Close() error {
+	return it.close(func() error {
+		var curErr error
+		if it.cur != nil {
+			curErr = it.cur.Close()
+		}
+
+		return combineErrors(it.err, curErr, it.src.Close())
+	})
+}
+
+func Skip[S any](src Iterer[S], skip int) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &skipIter[S]{
+			src:  src.Iter(),
+			skip: skip,
+		}
+	})
+}
+
+type skipIter[S any] struct {
+	closeGuard
+
+	src  Iter[S]
+	skip int
+
+	count int
+}
+
+func (it *skipIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	for it.count < it.skip {
+		it.count++
+		value, ok := it.src.Next()
+		if !ok {
+			return value, false
+		}
+	}
+
+	return it.src.Next()
+}
+
+func (it *skipIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func SkipLast[S any](src Iterer[S], count int) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &skipLastIter[S]{
+			src:    src.Iter(),
+			buffer: newRing[S](max(count, 0)),
+		}
+	})
+}
+
+type skipLastIter[S any] struct {
+	closeGuard
+
+	src    Iter[S]
+	buffer *ring[S]
+}
+
+func (it *skipLastIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	for {
+		value, ok := it.src.Next()
+		if !ok {
+			return value, false
+		}
+
+		if evicted, ok := it.buffer.Push(value); ok {
+			return evicted, true
+		}
+	}
+}
+
+func (it *skipLastIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func SkipWhile[S any](src Iterer[S], predicate func(S) bool) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &skipWhileIter[S]{
+			src:       src.Iter(),
+			predicate: predicate,
+		}
+	})
+}
+
+type skipWhileIter[S any] struct {
+	closeGuard
+
+	src       Iter[S]
+	predicate func(S) bool
+
+	skipped bool
+}
+
+func (it *skipWhileIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	if !it.skipped {
+		it.skipped = true
+		for {
+			value, ok := it.src.Next()
+			if !ok || !it.predicate(value) {
+				return value, ok
+			}
+		}
+	}
+
+	return it.src.Next()
+}
+
+func (it *skipWhileIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func SlidingWindow[S any](src Iterer[S], size int, step int) Iterer[[]S] {
+	if size <= 0 {
+		panic("size must be greater than 0")
+	}
+	if step <= 0 {
+		panic("step must be greater than 0")
+	}
+
+	return ItererFunc[[]S](func() Iter[[]S] {
+		return &windowIter[S]{
+			src:  src.Iter(),
+			size: size,
+			step: step,
+		}
+	})
+}
+
+type windowIter[S any] struct {
+	closeGuard
+
+	src     Iter[S]
+	size    int
+	step    int
+	partial bool
+
+	buf     []S
+	started bool
+	done    bool
+}
+
+func (it *windowIter[S]) Next() ([]S, bool) {
+	if it.closed || it.done {
+		return nil, false
+	}
+
+	if it.started {
+		if it.step < len(it.buf) {
+			n := copy(it.buf, it.buf[it.step:])
+			it.buf = it.buf[:n]
+		} else {
+			for skip := it.step - len(it.buf); skip > 0; skip-- {
+				if _, ok := it.src.Next(); !ok {
+					it.done = true
+					return nil, false
+				}
+			}
+
+			it.buf = it.buf[:0]
+		}
+	} else {
+		it.started = true
+		it.buf = make([]S, 0, it.size)
+	}
+
+	for len(it.buf) < it.size {
+		elem, ok := it.src.Next()
+		if !ok {
+			it.done = true
+			break
+		}
+
+		it.buf = append(it.buf, elem)
+	}
+
+	if len(it.buf) == 0 || (len(it.buf) < it.size && !it.partial) {
+		return nil, false
+	}
+
+	window := make([]S, len(it.buf))
+	copy(window, it.buf)
+	return window, true
+}
+
+func (it *windowIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func Take[S any](src Iterer[S], limit int) Iterer[S] {
+	if ordered, ok := src.(*OrderedIterer[S]); ok {
+		return ordered.take(limit)
+	}
+
+	return ItererFunc[S](func() Iter[S] {
+		return &takeIter[S]{
+			src:   src.Iter(),
+			limit: limit,
+		}
+	})
+}
+
+type takeIter[S any] struct {
+	closeGuard
+
+	src   Iter[S]
+	limit int
+
+	count int
+}
+
+func (it *takeIter[S]) Next() (S, bool) {
+	if it.closed || it.count >= it.limit {
+		var def S
+		return def, false
+	}
+
+	it.count++
+	return it.src.Next()
+}
+
+func (it *takeIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func TakeLast[S any](src Iterer[S], count int) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &takeLastIter[S]{
+			src:    src.Iter(),
+			buffer: newRing[S](max(count, 0)),
+		}
+	})
+}
+
+type takeLastIter[S any] struct {
+	closeGuard
+
+	src    Iter[S]
+	buffer *ring[S]
+
+	filled bool
+}
+
+func (it *takeLastIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	if !it.filled {
+		it.filled = true
+		for value, ok := it.src.Next(); ok; value, ok = it.src.Next() {
+			it.buffer.Push(value)
+		}
+	}
+
+	return it.buffer.Pop()
+}
+
+func (it *takeLastIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func TakeWhile[S any](src Iterer[S], predicate func(S) bool) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &takeWhileIter[S]{
+			src:       src.Iter(),
+			predicate: predicate,
+		}
+	})
+}
+
+type takeWhileIter[S any] struct {
+	closeGuard
+
+	src       Iter[S]
+	predicate func(S) bool
+
+	done bool
+}
+
+func (it *takeWhileIter[S]) Next() (S, bool) {
+	if it.closed || it.done {
+		var def S
+		return def, false
+	}
+
+	value, ok := it.src.Next()
+	if !ok || !it.predicate(value) {
+		it.done = true
+		var def S
+		return def, false
+	}
+
+	return value, true
+}
+
+func (it *takeWhileIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func Unzip[K comparable, V any](src Iterer[KeyValuePair[K, V]]) (Iterer[K], Iterer[V]) {
+	tees := Tee(src, 2)
+
+	keys := Select(tees[0], func(kvp KeyValuePair[K, V]) K {
+		return kvp.Key
+	})
+	values := Select(tees[1], func(kvp KeyValuePair[K, V]) V {
+		return kvp.Value
+	})
+
+	return keys, values
+}
+
+func Window[S any](src Iterer[S], size int) Iterer[[]S] {
+	return SlidingWindow(src, size, 1)
+}
+
+func Zip[S1, S2, R any](first Iterer[S1], second Iterer[S2], zipper func(S1, S2) R) Iterer[R] {
+	return ItererFunc[R](func() Iter[R] {
+		return &zipIter[S1, S2, R]{
+			first:  first.Iter(),
+			second: second.Iter(),
+			zipper: zipper,
+		}
+	})
+}
+
+type zipIter[S1, S2, R any] struct {
+	closeGuard
+
+	first  Iter[S1]
+	second Iter[S2]
+
+	zipper func(S1, S2) R
+}
+
+func (it *zipIter[S1, S2, R]) Next() (R, bool) {
+	if it.closed {
+		var def R
+		return def, false
+	}
+
+	value1, ok1 := it.first.Next()
+	value2, ok2 := it.second.Next()
+
+	if ok1 && ok2 {
+		return it.zipper(value1, value2), true
+	}
+
+	var def R
+	return def, false
+}
+
+func (it *zipIter[S1, S2, R]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.first.Close(), it.second.Close())
+	})
+}
+
+func Zip3[S1, S2, S3, R any](first Iterer[S1], second Iterer[S2], third Iterer[S3], zipper func(S1, S2, S3) R) Iterer[R] {
+	return ItererFunc[R](func() Iter[R] {
+		return &zip3Iter[S1, S2, S3, R]{
+			first:  first.Iter(),
+			second: second.Iter(),
+			third:  third.Iter(),
+			zipper: zipper,
+		}
+	})
+}
+
+type zip3Iter[S1, S2, S3, R any] struct {
+	closeGuard
+
+	first  Iter[S1]
+	second Iter[S2]
+	third  Iter[S3]
+
+	zipper func(S1, S2, S3) R
+}
+
+func (it *zip3Iter[S1, S2, S3, R]) Next() (R, bool) {
+	if it.closed {
+		var def R
+		return def, false
+	}
+
+	value1, ok1 := it.first.Next()
+	value2, ok2 := it.second.Next()
+	value3, ok3 := it.third.Next()
+
+	if ok1 && ok2 && ok3 {
+		return it.zipper(value1, value2, value3), true
+	}
+
+	var def R
+	return def, false
+}
+
+func (it *zip3Iter[S1, S2, S3, R]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.first.Close(), it.second.Close(), it.third.Close())
+	})
+}
+
+func ZipLongest[S1, S2, R any](first Iterer[S1], second Iterer[S2], fill1 S1, fill2 S2, zipper func(S1, S2) R) Iterer[R] {
+	return ItererFunc[R](func() Iter[R] {
+		return &zipLongestIter[S1, S2, R]{
+			first:  first.Iter(),
+			second: second.Iter(),
+			fill1:  fill1,
+			fill2:  fill2,
+			zipper: zipper,
+		}
+	})
+}
+
+type zipLongestIter[S1, S2, R any] struct {
+	closeGuard
+
+	first  Iter[S1]
+	second Iter[S2]
+	fill1  S1
+	fill2  S2
+
+	zipper func(S1, S2) R
+
+	firstDone  bool
+	secondDone bool
+}
+
+func (it *zipLongestIter[S1, S2, R]) Next() (R, bool) {
+	if it.closed {
+		var def R
+		return def, false
+	}
+
+	value1 := it.fill1
+	if !it.firstDone {
+		value, ok := it.first.Next()
+		if ok {
+			value1 = value
+		} else {
+			it.firstDone = true
+		}
+	}
+
+	value2 := it.fill2
+	if !it.secondDone {
+		value, ok := it.second.Next()
+		if ok {
+			value2 = value
+		} else {
+			it.secondDone = true
+		}
+	}
+
+	if it.firstDone && it.secondDone {
+		var def R
+		return def, false
+	}
+
+	return it.zipper(value1, value2), true
+}
+
+func (it *zipLongestIter[S1, S2, R]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.first.Close(), it.second.Close())
+	})
+}
+
+func ZipN[S any](srcs []Iterer[S]) Iterer[[]S] {
+	return ItererFunc[[]S](func() Iter[[]S] {
+		return &zipNIter[S]{
+			srcs: iterAll(srcs),
+		}
+	})
+}
+
+type zipNIter[S any] struct {
+	closeGuard
+
+	srcs []Iter[S]
+}
+
+func (it *zipNIter[S]) Next() ([]S, bool) {
+	if it.closed || len(it.srcs) == 0 {
+		return nil, false
+	}
+
+	values := make([]S, len(it.srcs))
+	for i, src := range it.srcs {
+		value, ok := src.Next()
+		if !ok {
+			return nil, false
+		}
+
+		values[i] = value
+	}
+
+	return values, true
+}
+
+func (it *zipNIter[S]) Close() error {
+	return it.close(func() error {
+		return closeAll(it.srcs)
+	})
 }
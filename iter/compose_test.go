@@ -1,6 +1,8 @@
 package iter_test
 
 import (
+	"errors"
+	"strconv"
 	"testing"
 
 	"github.com/shoenig/test/must"
@@ -8,6 +10,88 @@ import (
 	"github.com/craiggwilson/go-collections/iter"
 )
 
+// cursor reads values from a shared position, like a database cursor, so a
+// second Iter continues where the first stopped instead of starting over.
+func cursor(values ...int) iter.Iterer[int] {
+	pos := 0
+	return iter.Generate(func() (int, bool) {
+		if pos >= len(values) {
+			return 0, false
+		}
+
+		pos++
+		return values[pos-1], true
+	})
+}
+
+func TestChunk(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "even",
+			input:    []int{1, 3, 5, 7, 9, 11},
+			size:     2,
+			expected: [][]int{{1, 3}, {5, 7}, {9, 11}},
+		},
+		{
+			name:     "partial last chunk",
+			input:    []int{1, 3, 5, 7, 9},
+			size:     2,
+			expected: [][]int{{1, 3}, {5, 7}, {9}},
+		},
+		{
+			name:     "size larger than input",
+			input:    []int{1, 3, 5},
+			size:     5,
+			expected: [][]int{{1, 3, 5}},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			size:     2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.Chunk(it, tc.size))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("no aliasing", func(t *testing.T) {
+		it := iter.Chunk(iter.FromSlice([]int{1, 3, 5, 7}), 2).Iter()
+		first, ok := it.Next()
+		must.True(t, ok)
+		second, ok := it.Next()
+		must.True(t, ok)
+		must.NoError(t, it.Close())
+
+		second[0] = 100
+		must.Eq(t, []int{1, 3}, first)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{1, 3, 5}), iter.Err[int](expectedErr))
+
+		actual, err := iter.ToSlice(iter.Chunk(src, 2))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, [][]int{{1, 3}, {5}}, actual)
+	})
+}
+
 func TestConcat(t *testing.T) {
 	t.Parallel()
 
@@ -98,6 +182,77 @@ func TestDistinct(t *testing.T) {
 	}
 }
 
+func TestDistinctBy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{
+			name:     "no duplicates",
+			input:    []int{1, 2, 3},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "some duplicates",
+			input:    []int{1, 4, 3, 6, 5, 7},
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.DistinctBy(it, func(i int) int { return i % 3 }))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      []string
+		expected []iter.KeyValuePair[int, string]
+	}{
+		{
+			name: "values",
+			src:  []string{"a", "b", "c"},
+			expected: []iter.KeyValuePair[int, string]{
+				{Key: 0, Value: "a"},
+				{Key: 1, Value: "b"},
+				{Key: 2, Value: "c"},
+			},
+		},
+		{
+			name:     "empty",
+			src:      nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(iter.Enumerate(iter.FromSlice(tc.src)))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
 func TestFilter(t *testing.T) {
 	t.Parallel()
 
@@ -144,26 +299,53 @@ func TestFilter(t *testing.T) {
 	}
 }
 
-func TestSelect(t *testing.T) {
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	src := iter.FromSlice([]iter.Iterer[int]{
+		iter.FromSlice([]int{1, 2}),
+		iter.FromSlice[int](nil),
+		iter.FromSlice([]int{3}),
+	})
+
+	actual, err := iter.ToSlice(iter.Flatten(src))
+	must.NoError(t, err)
+	must.Eq(t, []int{1, 2, 3}, actual)
+}
+
+func TestFlattenSlices(t *testing.T) {
+	t.Parallel()
+
+	src := iter.FromSlice([][]int{{1, 2}, nil, {3}})
+
+	actual, err := iter.ToSlice(iter.FlattenSlices(src))
+	must.NoError(t, err)
+	must.Eq(t, []int{1, 2, 3}, actual)
+}
+
+func TestGroup(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name     string
 		input    []int
-		selector func(int) int
-		expected []int
+		expected []iter.Grouping[int, int]
 	}{
 		{
-			name:     "identity",
-			input:    []int{1, 3, 5, 7, 9},
-			selector: func(i int) int { return i },
-			expected: []int{1, 3, 5, 7, 9},
+			name:  "first-seen key order",
+			input: []int{5, 1, 3, 4, 6, 2},
+			expected: []iter.Grouping[int, int]{
+				{Key: 2, Values: []int{5, 2}},
+				{Key: 1, Values: []int{1, 4}},
+				{Key: 0, Values: []int{3, 6}},
+			},
 		},
 		{
-			name:     "add2",
-			input:    []int{1, 3, 5, 7, 9},
-			selector: func(i int) int { return i + 2 },
-			expected: []int{3, 5, 7, 9, 11},
+			name:  "single key",
+			input: []int{3, 6, 9},
+			expected: []iter.Grouping[int, int]{
+				{Key: 0, Values: []int{3, 6, 9}},
+			},
 		},
 		{
 			name:     "empty",
@@ -177,33 +359,48 @@ func TestSelect(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			it := iter.FromSlice(tc.input)
 
-			actual, err := iter.ToSlice(iter.Select(it, tc.selector))
+			actual, err := iter.ToSlice(iter.Group(it, func(i int) int { return i % 3 }))
 			must.NoError(t, err)
 			must.Eq(t, tc.expected, actual)
 		})
 	}
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{1, 2, 4}), iter.Err[int](expectedErr))
+
+		actual, err := iter.ToSlice(iter.Group(src, func(i int) int { return i % 3 }))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, []iter.Grouping[int, int]{
+			{Key: 1, Values: []int{1, 4}},
+			{Key: 2, Values: []int{2}},
+		}, actual)
+	})
 }
 
-func TestSelectMany(t *testing.T) {
+func TestGroupAdjacent(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name     string
 		input    []int
-		selector func(int) iter.Iterer[int]
-		expected []int
+		expected []iter.Grouping[int, int]
 	}{
 		{
-			name:     "identity",
-			input:    []int{1, 3, 5, 7, 9},
-			selector: func(i int) iter.Iterer[int] { return iter.FromSlice([]int{i}) },
-			expected: []int{1, 3, 5, 7, 9},
+			name:  "sorted",
+			input: []int{1, 3, 2, 4, 6, 5},
+			expected: []iter.Grouping[int, int]{
+				{Key: 1, Values: []int{1, 3}},
+				{Key: 0, Values: []int{2, 4, 6}},
+				{Key: 1, Values: []int{5}},
+			},
 		},
 		{
-			name:     "doubling",
-			input:    []int{1, 3, 5, 7, 9},
-			selector: func(i int) iter.Iterer[int] { return iter.Repeat(i, 2) },
-			expected: []int{1, 1, 3, 3, 5, 5, 7, 7, 9, 9},
+			name:  "single group",
+			input: []int{2, 4},
+			expected: []iter.Grouping[int, int]{
+				{Key: 0, Values: []int{2, 4}},
+			},
 		},
 		{
 			name:     "empty",
@@ -217,43 +414,94 @@ func TestSelectMany(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			it := iter.FromSlice(tc.input)
 
-			actual, err := iter.ToSlice(iter.SelectMany(it, tc.selector))
+			actual, err := iter.ToSlice(iter.GroupAdjacent(it, func(i int) int { return i % 2 }))
 			must.NoError(t, err)
 			must.Eq(t, tc.expected, actual)
 		})
 	}
+
+	t.Run("infinite source", func(t *testing.T) {
+		count := 0
+		src := iter.Generate(func() (int, bool) {
+			count++
+			return count, true
+		})
+
+		actual, err := iter.ToSlice(iter.Take(iter.GroupAdjacent(src, func(i int) int { return (i - 1) / 3 }), 2))
+		must.NoError(t, err)
+		must.Eq(t, []iter.Grouping[int, int]{
+			{Key: 0, Values: []int{1, 2, 3}},
+			{Key: 1, Values: []int{4, 5, 6}},
+		}, actual)
+	})
 }
 
-func TestSkip(t *testing.T) {
+func TestInterleave(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name     string
-		input    []int
-		skip     int
+		srcs     [][]int
 		expected []int
 	}{
 		{
-			name:     "all",
-			input:    []int{1, 3, 5, 7, 9},
-			skip:     5,
+			name:     "same length",
+			srcs:     [][]int{{1, 4}, {2, 5}, {3, 6}},
+			expected: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:     "stops at shortest",
+			srcs:     [][]int{{1, 4, 7}, {2}, {3, 6}},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "one empty",
+			srcs:     [][]int{{1, 2}, nil},
 			expected: nil,
 		},
 		{
-			name:     "none",
-			input:    []int{1, 3, 5, 7, 9},
-			skip:     0,
-			expected: []int{1, 3, 5, 7, 9},
+			name:     "no sources",
+			srcs:     nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			srcs := make([]iter.Iterer[int], len(tc.srcs))
+			for i, src := range tc.srcs {
+				srcs[i] = iter.FromSlice(src)
+			}
+
+			actual, err := iter.ToSlice(iter.Interleave(srcs...))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestIntersperse(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      []string
+		expected []string
+	}{
+		{
+			name:     "many",
+			src:      []string{"a", "b", "c"},
+			expected: []string{"a", ",", "b", ",", "c"},
 		},
 		{
-			name:     "some",
-			input:    []int{1, 3, 5, 7, 9},
-			skip:     3,
-			expected: []int{7, 9},
+			name:     "one",
+			src:      []string{"a"},
+			expected: []string{"a"},
 		},
 		{
 			name:     "empty",
-			input:    nil,
+			src:      nil,
 			expected: nil,
 		},
 	}
@@ -261,42 +509,31 @@ func TestSkip(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			it := iter.FromSlice(tc.input)
-
-			actual, err := iter.ToSlice(iter.Skip(it, tc.skip))
+			actual, err := iter.ToSlice(iter.Intersperse(iter.FromSlice(tc.src), ","))
 			must.NoError(t, err)
 			must.Eq(t, tc.expected, actual)
 		})
 	}
 }
 
-func TestTake(t *testing.T) {
+func TestPairwise(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name     string
 		input    []int
-		take     int
 		expected []int
 	}{
 		{
-			name:     "all",
-			input:    []int{1, 3, 5, 7, 9},
-			take:     5,
-			expected: []int{1, 3, 5, 7, 9},
+			name:     "many",
+			input:    []int{1, 3, 6, 10},
+			expected: []int{2, 3, 4},
 		},
 		{
-			name:     "none",
-			input:    []int{1, 3, 5, 7, 9},
-			take:     0,
+			name:     "single",
+			input:    []int{1},
 			expected: nil,
 		},
-		{
-			name:     "some",
-			input:    []int{1, 3, 5, 7, 9},
-			take:     3,
-			expected: []int{1, 3, 5},
-		},
 		{
 			name:     "empty",
 			input:    nil,
@@ -309,61 +546,155 @@ func TestTake(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			it := iter.FromSlice(tc.input)
 
-			actual, err := iter.ToSlice(iter.Take(it, tc.take))
+			actual, err := iter.ToSlice(iter.Pairwise(it, func(a, b int) int { return b - a }))
 			must.NoError(t, err)
 			must.Eq(t, tc.expected, actual)
 		})
 	}
 }
 
-func TestZip(t *testing.T) {
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	t.Run("sequential", func(t *testing.T) {
+		evens, odds := iter.Partition(iter.FromSlice([]int{1, 2, 3, 4, 5, 6, 7}), isEven)
+
+		actualEvens, err := iter.ToSlice(evens)
+		must.NoError(t, err)
+		actualOdds, err := iter.ToSlice(odds)
+		must.NoError(t, err)
+
+		must.Eq(t, []int{2, 4, 6}, actualEvens)
+		must.Eq(t, []int{1, 3, 5, 7}, actualOdds)
+	})
+
+	t.Run("interleaved", func(t *testing.T) {
+		evens, odds := iter.Partition(iter.FromSlice([]int{1, 3, 2, 4, 5}), isEven)
+		evenIt, oddIt := evens.Iter(), odds.Iter()
+
+		v, ok := evenIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 2, v)
+		v, ok = oddIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 1, v)
+		v, ok = oddIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 3, v)
+		v, ok = oddIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 5, v)
+		v, ok = evenIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 4, v)
+
+		must.NoError(t, evenIt.Close())
+		must.NoError(t, oddIt.Close())
+	})
+
+	t.Run("single pass", func(t *testing.T) {
+		passes := 0
+		src := iter.ItererFunc[int](func() iter.Iter[int] {
+			passes++
+			return iter.FromSlice([]int{1, 2, 3}).Iter()
+		})
+		evens, odds := iter.Partition(src, isEven)
+
+		_, err := iter.ToSlice(evens)
+		must.NoError(t, err)
+		_, err = iter.ToSlice(odds)
+		must.NoError(t, err)
+		must.Eq(t, 1, passes)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		evens, odds := iter.Partition(iter.Concat(iter.FromSlice([]int{1, 2}), iter.Err[int](expectedErr)), isEven)
+
+		_, err := iter.ToSlice(evens)
+		must.ErrorIs(t, err, expectedErr)
+		_, err = iter.ToSlice(odds)
+		must.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("early close", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := &countingSource{values: []int{1, 2, 3, 4, 5, 6}, err: expectedErr}
+		evens, odds := iter.Partition[int](src, isEven)
+
+		actual, err := iter.ToSlice(iter.Take(evens, 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{2}, actual)
+		must.Eq(t, 0, src.closes)
+		must.Eq(t, 2, src.reads)
+
+		actual, err = iter.ToSlice(odds)
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, []int{1, 3, 5}, actual)
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("early close waits for other side", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 2, 3, 4}}
+		evens, odds := iter.Partition[int](src, isEven)
+		evenIt, oddIt := evens.Iter(), odds.Iter()
+
+		v, ok := evenIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 2, v)
+		must.NoError(t, evenIt.Close())
+		must.Eq(t, 0, src.closes)
+
+		v, ok = oddIt.Next()
+		must.True(t, ok)
+		must.Eq(t, 1, v)
+		must.NoError(t, oddIt.Close())
+		must.Eq(t, 1, src.closes)
+		must.Eq(t, 1, src.iters)
+	})
+
+	t.Run("cursor source is shared", func(t *testing.T) {
+		evens, odds := iter.Partition(cursor(1, 2, 3, 4, 5, 6), isEven)
+
+		actual, err := iter.ToSlice(iter.Take(evens, 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{2}, actual)
+
+		actual, err = iter.ToSlice(odds)
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+	})
+}
+
+func TestRoundRobin(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name     string
-		left     []int
-		right    []int
-		zipper   func(int, int) int
+		srcs     [][]int
 		expected []int
 	}{
 		{
 			name:     "same length",
-			left:     []int{1, 3, 5},
-			right:    []int{2, 4, 6},
-			zipper:   func(a, b int) int { return a + b },
-			expected: []int{3, 7, 11},
-		},
-		{
-			name:     "right shorter",
-			left:     []int{1, 3, 5, 7, 9},
-			right:    []int{2, 4, 6},
-			zipper:   func(a, b int) int { return a + b },
-			expected: []int{3, 7, 11},
-		},
-		{
-			name:     "left shorter",
-			left:     []int{1, 3, 5},
-			right:    []int{2, 4, 6, 8, 10},
-			zipper:   func(a, b int) int { return a + b },
-			expected: []int{3, 7, 11},
+			srcs:     [][]int{{1, 4}, {2, 5}, {3, 6}},
+			expected: []int{1, 2, 3, 4, 5, 6},
 		},
 		{
-			name:     "empty right",
-			left:     []int{1, 3, 5, 7, 9},
-			right:    nil,
-			zipper:   func(a, b int) int { return a + b },
-			expected: nil,
+			name:     "skips exhausted",
+			srcs:     [][]int{{1, 4, 7, 8}, {2}, {3, 6}},
+			expected: []int{1, 2, 3, 4, 6, 7, 8},
 		},
 		{
-			name:     "empty left",
-			left:     nil,
-			right:    []int{1, 3, 5, 7, 9},
-			expected: nil,
+			name:     "some empty",
+			srcs:     [][]int{nil, {1, 2}, nil},
+			expected: []int{1, 2},
 		},
 		{
-			name:     "empty both",
-			left:     nil,
-			right:    nil,
+			name:     "no sources",
+			srcs:     nil,
 			expected: nil,
 		},
 	}
@@ -371,10 +702,743 @@ func TestZip(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			left := iter.FromSlice(tc.left)
-			right := iter.FromSlice(tc.right)
+			srcs := make([]iter.Iterer[int], len(tc.srcs))
+			for i, src := range tc.srcs {
+				srcs[i] = iter.FromSlice(src)
+			}
 
-			actual, err := iter.ToSlice(iter.Zip(left, right, tc.zipper))
+			actual, err := iter.ToSlice(iter.RoundRobin(srcs...))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		selector func(int) int
+		expected []int
+	}{
+		{
+			name:     "identity",
+			input:    []int{1, 3, 5, 7, 9},
+			selector: func(i int) int { return i },
+			expected: []int{1, 3, 5, 7, 9},
+		},
+		{
+			name:     "add2",
+			input:    []int{1, 3, 5, 7, 9},
+			selector: func(i int) int { return i + 2 },
+			expected: []int{3, 5, 7, 9, 11},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.Select(it, tc.selector))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSelectMany(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		selector func(int) iter.Iterer[int]
+		expected []int
+	}{
+		{
+			name:     "identity",
+			input:    []int{1, 3, 5, 7, 9},
+			selector: func(i int) iter.Iterer[int] { return iter.FromSlice([]int{i}) },
+			expected: []int{1, 3, 5, 7, 9},
+		},
+		{
+			name:     "doubling",
+			input:    []int{1, 3, 5, 7, 9},
+			selector: func(i int) iter.Iterer[int] { return iter.Repeat(i, 2) },
+			expected: []int{1, 1, 3, 3, 5, 5, 7, 7, 9, 9},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.SelectMany(it, tc.selector))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSkip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		skip     int
+		expected []int
+	}{
+		{
+			name:     "all",
+			input:    []int{1, 3, 5, 7, 9},
+			skip:     5,
+			expected: nil,
+		},
+		{
+			name:     "none",
+			input:    []int{1, 3, 5, 7, 9},
+			skip:     0,
+			expected: []int{1, 3, 5, 7, 9},
+		},
+		{
+			name:     "some",
+			input:    []int{1, 3, 5, 7, 9},
+			skip:     3,
+			expected: []int{7, 9},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.Skip(it, tc.skip))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSkipLast(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		count    int
+		expected []int
+	}{
+		{
+			name:     "some",
+			input:    []int{1, 3, 5, 7, 9},
+			count:    2,
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "none",
+			input:    []int{1, 3, 5},
+			count:    0,
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "all",
+			input:    []int{1, 3, 5},
+			count:    5,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			count:    2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.SkipLast(it, tc.count))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSkipWhile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		limit    int
+		expected []int
+	}{
+		{
+			name:     "some",
+			input:    []int{1, 3, 5, 1, 9},
+			limit:    4,
+			expected: []int{5, 1, 9},
+		},
+		{
+			name:     "none",
+			input:    []int{1, 3, 5},
+			limit:    0,
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "all",
+			input:    []int{1, 3, 5},
+			limit:    10,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			limit:    2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.SkipWhile(it, func(i int) bool { return i < tc.limit }))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{
+			name:     "step smaller than size",
+			input:    []int{1, 3, 5, 7, 9},
+			size:     3,
+			step:     2,
+			expected: [][]int{{1, 3, 5}, {5, 7, 9}},
+		},
+		{
+			name:     "step equal to size",
+			input:    []int{1, 3, 5, 7, 9},
+			size:     2,
+			step:     2,
+			expected: [][]int{{1, 3}, {5, 7}},
+		},
+		{
+			name:     "step larger than size",
+			input:    []int{1, 3, 5, 7, 9, 11, 13},
+			size:     2,
+			step:     3,
+			expected: [][]int{{1, 3}, {7, 9}},
+		},
+		{
+			name:     "size larger than input",
+			input:    []int{1, 3},
+			size:     3,
+			step:     1,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			size:     2,
+			step:     1,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.SlidingWindow(it, tc.size, tc.step))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		take     int
+		expected []int
+	}{
+		{
+			name:     "all",
+			input:    []int{1, 3, 5, 7, 9},
+			take:     5,
+			expected: []int{1, 3, 5, 7, 9},
+		},
+		{
+			name:     "none",
+			input:    []int{1, 3, 5, 7, 9},
+			take:     0,
+			expected: nil,
+		},
+		{
+			name:     "some",
+			input:    []int{1, 3, 5, 7, 9},
+			take:     3,
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.Take(it, tc.take))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTakeLast(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		count    int
+		expected []int
+	}{
+		{
+			name:     "some",
+			input:    []int{1, 3, 5, 7, 9},
+			count:    2,
+			expected: []int{7, 9},
+		},
+		{
+			name:     "none",
+			input:    []int{1, 3, 5},
+			count:    0,
+			expected: nil,
+		},
+		{
+			name:     "more than available",
+			input:    []int{1, 3, 5},
+			count:    5,
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			count:    2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.TakeLast(it, tc.count))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTakeWhile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		limit    int
+		expected []int
+	}{
+		{
+			name:     "some",
+			input:    []int{1, 3, 5, 1, 9},
+			limit:    4,
+			expected: []int{1, 3},
+		},
+		{
+			name:     "none",
+			input:    []int{1, 3, 5},
+			limit:    0,
+			expected: nil,
+		},
+		{
+			name:     "all",
+			input:    []int{1, 3, 5},
+			limit:    10,
+			expected: []int{1, 3, 5},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			limit:    2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.TakeWhile(it, func(i int) bool { return i < tc.limit }))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	t.Parallel()
+
+	src := iter.FromSlice([]iter.KeyValuePair[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "b", Value: 2},
+		{Key: "c", Value: 3},
+	})
+
+	keys, values := iter.Unzip(src)
+
+	keysIt := keys.Iter()
+	valuesIt := values.Iter()
+
+	var actualKeys []string
+	var actualValues []int
+	for {
+		key, kok := keysIt.Next()
+		value, vok := valuesIt.Next()
+		if !kok || !vok {
+			must.Eq(t, kok, vok)
+			break
+		}
+
+		actualKeys = append(actualKeys, key)
+		actualValues = append(actualValues, value)
+	}
+
+	must.NoError(t, keysIt.Close())
+	must.NoError(t, valuesIt.Close())
+	must.Eq(t, []string{"a", "b", "c"}, actualKeys)
+	must.Eq(t, []int{1, 2, 3}, actualValues)
+
+	t.Run("early close waits for other side", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 2, 3}}
+		pairs := iter.Select[int](src, func(v int) iter.KeyValuePair[int, int] {
+			return iter.KeyValuePair[int, int]{Key: v, Value: v * 10}
+		})
+
+		keys, values := iter.Unzip(pairs)
+
+		actualKeys, err := iter.ToSlice(iter.Take(keys, 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actualKeys)
+		must.Eq(t, 0, src.closes)
+
+		actualValues, err := iter.ToSlice(values)
+		must.NoError(t, err)
+		must.Eq(t, []int{10, 20, 30}, actualValues)
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("cursor source is shared", func(t *testing.T) {
+		pairs := iter.Select(cursor(1, 2, 3), func(v int) iter.KeyValuePair[int, int] {
+			return iter.KeyValuePair[int, int]{Key: v, Value: v * 10}
+		})
+
+		keys, values := iter.Unzip(pairs)
+
+		actualKeys, err := iter.ToSlice(iter.Take(keys, 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actualKeys)
+
+		actualValues, err := iter.ToSlice(values)
+		must.NoError(t, err)
+		must.Eq(t, []int{10, 20, 30}, actualValues)
+	})
+}
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "many",
+			input:    []int{1, 3, 5, 7},
+			size:     2,
+			expected: [][]int{{1, 3}, {3, 5}, {5, 7}},
+		},
+		{
+			name:     "size equal to input",
+			input:    []int{1, 3, 5},
+			size:     3,
+			expected: [][]int{{1, 3, 5}},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			size:     2,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.Window(it, tc.size))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		left     []int
+		right    []int
+		zipper   func(int, int) int
+		expected []int
+	}{
+		{
+			name:     "same length",
+			left:     []int{1, 3, 5},
+			right:    []int{2, 4, 6},
+			zipper:   func(a, b int) int { return a + b },
+			expected: []int{3, 7, 11},
+		},
+		{
+			name:     "right shorter",
+			left:     []int{1, 3, 5, 7, 9},
+			right:    []int{2, 4, 6},
+			zipper:   func(a, b int) int { return a + b },
+			expected: []int{3, 7, 11},
+		},
+		{
+			name:     "left shorter",
+			left:     []int{1, 3, 5},
+			right:    []int{2, 4, 6, 8, 10},
+			zipper:   func(a, b int) int { return a + b },
+			expected: []int{3, 7, 11},
+		},
+		{
+			name:     "empty right",
+			left:     []int{1, 3, 5, 7, 9},
+			right:    nil,
+			zipper:   func(a, b int) int { return a + b },
+			expected: nil,
+		},
+		{
+			name:     "empty left",
+			left:     nil,
+			right:    []int{1, 3, 5, 7, 9},
+			expected: nil,
+		},
+		{
+			name:     "empty both",
+			left:     nil,
+			right:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			left := iter.FromSlice(tc.left)
+			right := iter.FromSlice(tc.right)
+
+			actual, err := iter.ToSlice(iter.Zip(left, right, tc.zipper))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		first    []int
+		second   []int
+		third    []int
+		expected []int
+	}{
+		{
+			name:     "same length",
+			first:    []int{1, 2, 3},
+			second:   []int{10, 20, 30},
+			third:    []int{100, 200, 300},
+			expected: []int{111, 222, 333},
+		},
+		{
+			name:     "third shorter",
+			first:    []int{1, 2, 3},
+			second:   []int{10, 20, 30},
+			third:    []int{100},
+			expected: []int{111},
+		},
+		{
+			name:     "empty first",
+			first:    nil,
+			second:   []int{10, 20, 30},
+			third:    []int{100, 200, 300},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			zipped := iter.Zip3(iter.FromSlice(tc.first), iter.FromSlice(tc.second), iter.FromSlice(tc.third), func(a, b, c int) int {
+				return a + b + c
+			})
+
+			actual, err := iter.ToSlice(zipped)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestZipLongest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		left     []int
+		right    []string
+		expected []string
+	}{
+		{
+			name:     "same length",
+			left:     []int{1, 2},
+			right:    []string{"a", "b"},
+			expected: []string{"1a", "2b"},
+		},
+		{
+			name:     "right shorter",
+			left:     []int{1, 2, 3},
+			right:    []string{"a"},
+			expected: []string{"1a", "2-", "3-"},
+		},
+		{
+			name:     "left shorter",
+			left:     []int{1},
+			right:    []string{"a", "b", "c"},
+			expected: []string{"1a", "0b", "0c"},
+		},
+		{
+			name:     "empty both",
+			left:     nil,
+			right:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			zipped := iter.ZipLongest(iter.FromSlice(tc.left), iter.FromSlice(tc.right), 0, "-", func(a int, b string) string {
+				return strconv.Itoa(a) + b
+			})
+
+			actual, err := iter.ToSlice(zipped)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestZipN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		srcs     [][]int
+		expected [][]int
+	}{
+		{
+			name:     "same length",
+			srcs:     [][]int{{1, 2}, {3, 4}, {5, 6}},
+			expected: [][]int{{1, 3, 5}, {2, 4, 6}},
+		},
+		{
+			name:     "one shorter",
+			srcs:     [][]int{{1, 2}, {3}, {5, 6}},
+			expected: [][]int{{1, 3, 5}},
+		},
+		{
+			name:     "no sources",
+			srcs:     nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			srcs := make([]iter.Iterer[int], len(tc.srcs))
+			for i, src := range tc.srcs {
+				srcs[i] = iter.FromSlice(src)
+			}
+
+			actual, err := iter.ToSlice(iter.ZipN(srcs))
 			must.NoError(t, err)
 			must.Eq(t, tc.expected, actual)
 		})
@@ -0,0 +1,117 @@
+package iter
+
+import (
+	"context"
+
+	"golang.org/x/exp/constraints"
+)
+
+func WithContext[S any](ctx context.Context, src Iterer[S]) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &contextIter[S]{
+			ctx: ctx,
+			src: src.Iter(),
+		}
+	})
+}
+
+type contextIter[S any] struct {
+	closeGuard
+
+	ctx context.Context
+	src Iter[S]
+
+	err error
+}
+
+func (it *contextIter[S]) Next() (S, bool) {
+	if it.closed || it.err != nil {
+		var def S
+		return def, false
+	}
+
+	if it.err = it.ctx.Err(); it.err != nil {
+		var def S
+		return def, false
+	}
+
+	return it.src.Next()
+}
+
+func (it *contextIter[S]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.err, it.src.Close())
+	})
+}
+
+func AllContext[S any](ctx context.Context, src Iterer[S], predicate func(S) bool) (bool, error) {
+	return All(WithContext(ctx, src), predicate)
+}
+
+func AnyContext[S any](ctx context.Context, src Iterer[S], predicate func(S) bool) (bool, error) {
+	return Any(WithContext(ctx, src), predicate)
+}
+
+func CollectContext[S any](ctx context.Context, src Iterer[S], dst interface{ Add(S) }) error {
+	return Collect(WithContext(ctx, src), dst)
+}
+
+func ContainsContext[S comparable](ctx context.Context, src Iterer[S], target S) (bool, error) {
+	return Contains(WithContext(ctx, src), target)
+}
+
+func ElementAtContext[S any](ctx context.Context, src Iterer[S], idx uint) (S, error) {
+	return ElementAt(WithContext(ctx, src), idx)
+}
+
+func FirstContext[S any](ctx context.Context, src Iterer[S]) (S, error) {
+	return First(WithContext(ctx, src))
+}
+
+func FirstOrDefaultContext[S any](ctx context.Context, src Iterer[S]) (S, error) {
+	return FirstOrDefault(WithContext(ctx, src))
+}
+
+func FoldContext[S, R any](ctx context.Context, src Iterer[S], seed R, reducer func(R, S) R) (R, error) {
+	return Fold(WithContext(ctx, src), seed, reducer)
+}
+
+func LastContext[S any](ctx context.Context, src Iterer[S]) (S, error) {
+	return Last(WithContext(ctx, src))
+}
+
+func LastOrDefaultContext[S any](ctx context.Context, src Iterer[S]) (S, error) {
+	return LastOrDefault(WithContext(ctx, src))
+}
+
+func LenContext[S any](ctx context.Context, src Iterer[S]) (int, error) {
+	if counter, ok := src.(interface{ Len() int }); ok {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
+		return counter.Len(), nil
+	}
+
+	return Len(WithContext(ctx, src))
+}
+
+func MaxContext[S constraints.Ordered](ctx context.Context, src Iterer[S]) (S, error) {
+	return Max(WithContext(ctx, src))
+}
+
+func MinContext[S constraints.Ordered](ctx context.Context, src Iterer[S]) (S, error) {
+	return Min(WithContext(ctx, src))
+}
+
+func ReduceContext[S any](ctx context.Context, src Iterer[S], reducer func(S, S) S) (S, error) {
+	return Reduce(WithContext(ctx, src), reducer)
+}
+
+func SumContext[S constraints.Integer | constraints.Float](ctx context.Context, src Iterer[S]) (S, error) {
+	return Sum(WithContext(ctx, src))
+}
+
+func ToSliceContext[S any](ctx context.Context, src Iterer[S]) ([]S, error) {
+	return ToSlice(WithContext(ctx, src))
+}
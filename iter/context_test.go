@@ -0,0 +1,78 @@
+package iter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestWithContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not cancelled", func(t *testing.T) {
+		src := iter.FromSlice([]int{1, 3, 5})
+
+		actual, err := iter.ToSlice(iter.WithContext(context.Background(), src))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+	})
+
+	t.Run("cancelled mid-stream", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		count := 0
+		src := iter.Generate(func() (int, bool) {
+			count++
+			if count == 3 {
+				cancel()
+			}
+			return count, true
+		})
+
+		actual, err := iter.ToSlice(iter.WithContext(ctx, src))
+		must.ErrorIs(t, err, context.Canceled)
+		must.Eq(t, []int{1, 2, 3}, actual)
+	})
+
+	t.Run("deadline exceeded", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		actual, err := iter.ToSlice(iter.WithContext(ctx, iter.Repeat(1, 10)))
+		must.ErrorIs(t, err, context.DeadlineExceeded)
+		must.Nil(t, actual)
+	})
+}
+
+func Test_ContextTerminals(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	src := iter.FromSlice([]int{1, 3, 5})
+
+	_, err := iter.AnyContext(ctx, src, func(i int) bool { return i > 3 })
+	must.ErrorIs(t, err, context.Canceled)
+
+	_, err = iter.FirstContext(ctx, src)
+	must.ErrorIs(t, err, context.Canceled)
+
+	_, err = iter.FoldContext(ctx, src, 0, func(acc, i int) int { return acc + i })
+	must.ErrorIs(t, err, context.Canceled)
+
+	_, err = iter.LenContext(ctx, src)
+	must.ErrorIs(t, err, context.Canceled)
+
+	_, err = iter.ToSliceContext(ctx, src)
+	must.ErrorIs(t, err, context.Canceled)
+
+	sum, err := iter.SumContext(context.Background(), src)
+	must.NoError(t, err)
+	must.Eq(t, 9, sum)
+}
@@ -2,12 +2,58 @@ package iter
 
 import (
 	"errors"
+	"strings"
 )
 
+var ErrAlreadyIterated = errors.New("already iterated")
+
+var ErrClosed = errors.New("source already closed")
+
 var ErrEmptyIter = errors.New("contains no elements")
 
 var ErrOutOfRange = errors.New("out of range")
 
+var ErrPanic = errors.New("recovered from panic")
+
+var ErrUnsorted = errors.New("not sorted")
+
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+func combineErrors(errs ...error) error {
+	var combined []error
+	for _, err := range errs {
+		if multi, ok := err.(*MultiError); ok {
+			combined = append(combined, multi.Errors...)
+		} else if err != nil {
+			combined = append(combined, err)
+		}
+	}
+
+	switch len(combined) {
+	case 0:
+		return nil
+	case 1:
+		return combined[0]
+	default:
+		return &MultiError{Errors: combined}
+	}
+}
+
 func Err[S any](err error) Iterer[S] {
 	return ItererFunc[S](func() Iter[S] {
 		return &errIter[S]{err}
@@ -0,0 +1,163 @@
+package iter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestCloseErrors(t *testing.T) {
+	t.Parallel()
+
+	err1 := errors.New("first")
+	err2 := errors.New("second")
+
+	testCases := []struct {
+		name     string
+		input    iter.Iterer[int]
+		expected []error
+	}{
+		{
+			name:     "concat",
+			input:    iter.Concat(iter.Err[int](err1), iter.Err[int](err2)),
+			expected: []error{err1, err2},
+		},
+		{
+			name:     "zip",
+			input:    iter.Zip(iter.Err[int](err1), iter.Err[int](err2), func(a, b int) int { return a + b }),
+			expected: []error{err1, err2},
+		},
+		{
+			name:     "interleave",
+			input:    iter.Interleave(iter.Err[int](err1), iter.Err[int](err2)),
+			expected: []error{err1, err2},
+		},
+		{
+			name:     "round robin",
+			input:    iter.RoundRobin(iter.Err[int](err1), iter.Err[int](err2)),
+			expected: []error{err1, err2},
+		},
+		{
+			name:     "zip n",
+			input:    iter.Select(iter.ZipN([]iter.Iterer[int]{iter.Err[int](err1), iter.Err[int](err2)}), func(v []int) int { return len(v) }),
+			expected: []error{err1, err2},
+		},
+		{
+			name: "select many",
+			input: iter.SelectMany(
+				iter.Concat(iter.FromSlice([]int{1}), iter.Err[int](err2)),
+				func(int) iter.Iterer[int] { return iter.Err[int](err1) },
+			),
+			expected: []error{err1, err2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := iter.ToSlice(tc.input)
+
+			var multi *iter.MultiError
+			must.True(t, errors.As(err, &multi))
+			must.Eq(t, tc.expected, multi.Errors)
+			for _, expected := range tc.expected {
+				must.ErrorIs(t, err, expected)
+			}
+		})
+	}
+
+	t.Run("single error is not wrapped", func(t *testing.T) {
+		_, err := iter.ToSlice(iter.Concat(iter.FromSlice([]int{1}), iter.Err[int](err1)))
+		must.Eq(t, err1, err)
+	})
+
+	t.Run("terminal keeps its own error", func(t *testing.T) {
+		_, err := iter.First(iter.Err[int](err1))
+		must.ErrorIs(t, err, iter.ErrEmptyIter)
+		must.ErrorIs(t, err, err1)
+	})
+}
+
+func TestCloseIdempotent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		input iter.Iterer[int]
+	}{
+		{
+			name:  "slice",
+			input: iter.FromSlice([]int{1, 2, 3}),
+		},
+		{
+			name:  "generate",
+			input: iter.Repeat(1, 3),
+		},
+		{
+			name:  "concat",
+			input: iter.Concat(iter.FromSlice([]int{1}), iter.FromSlice([]int{2, 3})),
+		},
+		{
+			name:  "select many",
+			input: iter.SelectMany(iter.FromSlice([]int{1, 2, 3}), func(i int) iter.Iterer[int] { return iter.Repeat(i, 2) }),
+		},
+		{
+			name:  "window",
+			input: iter.Select(iter.Window(iter.FromSlice([]int{1, 2, 3}), 2), func(w []int) int { return w[0] }),
+		},
+		{
+			name:  "parallel",
+			input: iter.ParallelSelect(iter.FromSlice([]int{1, 2, 3}), func(i int) int { return i }),
+		},
+		{
+			name:  "prefetch",
+			input: iter.Prefetch(iter.FromSlice([]int{1, 2, 3}), 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := tc.input.Iter()
+
+			_, ok := it.Next()
+			must.True(t, ok)
+
+			must.NoError(t, it.Close())
+			must.NoError(t, it.Close())
+
+			_, ok = it.Next()
+			must.False(t, ok)
+		})
+	}
+
+	t.Run("error is remembered", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		closes := 0
+		src := iter.ItererFunc[int](func() iter.Iter[int] {
+			return &countingIter{closes: &closes, err: expectedErr}
+		})
+
+		it := iter.Select(src, func(i int) int { return i }).Iter()
+		must.ErrorIs(t, it.Close(), expectedErr)
+		must.ErrorIs(t, it.Close(), expectedErr)
+		must.Eq(t, 1, closes)
+	})
+}
+
+type countingIter struct {
+	closes *int
+	err    error
+}
+
+func (it *countingIter) Next() (int, bool) {
+	return 0, false
+}
+
+func (it *countingIter) Close() error {
+	*it.closes++
+	return it.err
+}
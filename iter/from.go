@@ -36,12 +36,14 @@ func (itr *sliceIterer[T]) ToSlice() []T {
 }
 
 type sliceIter[T any] struct {
+	closeGuard
+
 	values []T
 	pos    int
 }
 
 func (it *sliceIter[T]) Next() (T, bool) {
-	if it.pos < len(it.values) {
+	if !it.closed && it.pos < len(it.values) {
 		it.pos++
 		return it.values[it.pos-1], true
 	}
@@ -51,5 +53,6 @@ func (it *sliceIter[T]) Next() (T, bool) {
 }
 
 func (it *sliceIter[T]) Close() error {
+	it.closed = true
 	return nil
 }
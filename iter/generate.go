@@ -1,6 +1,85 @@
 package iter
 
-import "golang.org/x/exp/constraints"
+import (
+	"math"
+
+	"golang.org/x/exp/constraints"
+)
+
+func Cycle[T any](src Iterer[T]) Iterer[T] {
+	return ItererFunc[T](func() Iter[T] {
+		return &cycleIter[T]{
+			src:   src,
+			count: -1,
+		}
+	})
+}
+
+func CycleN[T any](src Iterer[T], count int) Iterer[T] {
+	if count < 0 {
+		panic("count must not be negative")
+	}
+
+	return ItererFunc[T](func() Iter[T] {
+		return &cycleIter[T]{
+			src:   src,
+			count: count,
+		}
+	})
+}
+
+type cycleIter[T any] struct {
+	closeGuard
+
+	src   Iterer[T]
+	count int
+
+	cur     Iter[T]
+	passes  int
+	yielded bool
+	done    bool
+	err     error
+}
+
+func (it *cycleIter[T]) Next() (T, bool) {
+	for !it.closed && !it.done {
+		if it.cur == nil {
+			if it.count >= 0 && it.passes >= it.count {
+				it.done = true
+				break
+			}
+
+			it.cur = it.src.Iter()
+			it.passes++
+			it.yielded = false
+		}
+
+		value, ok := it.cur.Next()
+		if ok {
+			it.yielded = true
+			return value, true
+		}
+
+		it.err = it.cur.Close()
+		it.cur = nil
+		if it.err != nil || !it.yielded {
+			it.done = true
+		}
+	}
+
+	var def T
+	return def, false
+}
+
+func (it *cycleIter[T]) Close() error {
+	return it.close(func() error {
+		if it.cur == nil {
+			return it.err
+		}
+
+		return combineErrors(it.err, it.cur.Close())
+	})
+}
 
 func Generate[T any](generator func() (T, bool)) Iterer[T] {
 	return ItererFunc[T](func() Iter[T] {
@@ -11,12 +90,14 @@ func Generate[T any](generator func() (T, bool)) Iterer[T] {
 }
 
 type generateIter[T any] struct {
+	closeGuard
+
 	generator func() (T, bool)
 	done      bool
 }
 
 func (it *generateIter[T]) Next() (T, bool) {
-	if it.done {
+	if it.closed || it.done {
 		var def T
 		return def, false
 	}
@@ -30,46 +111,321 @@ func (it *generateIter[T]) Next() (T, bool) {
 }
 
 func (it *generateIter[T]) Close() error {
+	it.closed = true
 	return nil
 }
 
-func Range[T constraints.Integer | constraints.Float](from T, to T, step T) Iterer[T] {
-	count := T(0)
-	return Generate(func() (T, bool) {
-		value := from + count*step
-		count++
-		if value < to {
-			return value, true
+func Iterate[T any](seed T, f func(T) T) Iterer[T] {
+	return ItererFunc[T](func() Iter[T] {
+		return &iterateIter[T]{
+			value: seed,
+			f:     f,
 		}
+	})
+}
+
+type iterateIter[T any] struct {
+	closeGuard
+
+	value   T
+	f       func(T) T
+	started bool
+}
 
+func (it *iterateIter[T]) Next() (T, bool) {
+	if it.closed {
 		var def T
 		return def, false
-	})
+	}
+
+	if it.started {
+		it.value = it.f(it.value)
+	}
+
+	it.started = true
+	return it.value, true
+}
+
+func (it *iterateIter[T]) Close() error {
+	it.closed = true
+	return nil
+}
+
+func Linspace[T constraints.Float](from T, to T, count int) Iterer[T] {
+	if count < 0 {
+		panic("count must not be negative")
+	}
+
+	return &linspaceIterer[T]{
+		from:  from,
+		to:    to,
+		count: count,
+	}
+}
+
+type linspaceIterer[T constraints.Float] struct {
+	from  T
+	to    T
+	count int
+}
+
+func (itr *linspaceIterer[T]) Iter() Iter[T] {
+	return &linspaceIter[T]{
+		from:  itr.from,
+		to:    itr.to,
+		count: itr.count,
+	}
+}
+
+func (itr *linspaceIterer[T]) Len() int {
+	return itr.count
+}
+
+type linspaceIter[T constraints.Float] struct {
+	closeGuard
+
+	from  T
+	to    T
+	count int
+	pos   int
+}
+
+func (it *linspaceIter[T]) Next() (T, bool) {
+	if it.closed || it.pos >= it.count {
+		var def T
+		return def, false
+	}
+
+	pos := it.pos
+	it.pos++
+
+	switch pos {
+	case 0:
+		return it.from, true
+	case it.count - 1:
+		return it.to, true
+	default:
+		return it.from + (it.to-it.from)*T(pos)/T(it.count-1), true
+	}
+}
+
+func (it *linspaceIter[T]) Close() error {
+	it.closed = true
+	return nil
+}
+
+func Range[T constraints.Integer | constraints.Float](from T, to T, step T) Iterer[T] {
+	return newRange(from, to, step, false)
 }
 
 func RangeInclusive[T constraints.Integer | constraints.Float](from T, to T, step T) Iterer[T] {
-	count := T(0)
-	return Generate(func() (T, bool) {
-		value := from + count*step
-		count++
-		if value <= to {
-			return value, true
+	return newRange(from, to, step, true)
+}
+
+func newRange[T constraints.Integer | constraints.Float](from T, to T, step T, inclusive bool) Iterer[T] {
+	if step == 0 {
+		panic("step must not be zero")
+	}
+
+	count := rangeCount(from, to, step, inclusive)
+	if count < 0 {
+		return ItererFunc[T](func() Iter[T] {
+			return &rangeIter[T]{
+				from:  from,
+				step:  step,
+				count: count,
+			}
+		})
+	}
+
+	return &rangeIterer[T]{
+		from:  from,
+		step:  step,
+		count: count,
+	}
+}
+
+// rangeCount computes the number of values from + i*step that lie before to (or
+// on it, when inclusive), or -1 when a float range is too large to count, such
+// as one bounded by infinity. Each value is computed by multiplication rather
+// than accumulation, so floating point ranges do not drift.
+func rangeCount[T constraints.Integer | constraints.Float](from T, to T, step T, inclusive bool) int {
+	within := func(value T) bool {
+		switch {
+		case step > 0 && inclusive:
+			return value <= to
+		case step > 0:
+			return value < to
+		case inclusive:
+			return value >= to
+		default:
+			return value > to
 		}
+	}
+
+	if !within(from) {
+		return 0
+	}
+
+	// Integer division truncates one half to zero; float division does not.
+	if one, two := T(1), T(2); one/two == 0 {
+		// Converting through uint64 keeps the distance exact even when it
+		// overflows T, such as int8(-100) to int8(100).
+		var dist, stride uint64
+		if step > 0 {
+			dist, stride = uint64(to)-uint64(from), uint64(step)
+		} else {
+			dist, stride = uint64(from)-uint64(to), -uint64(step)
+		}
+
+		if inclusive {
+			return int(dist/stride + 1)
+		}
+
+		return int((dist-1)/stride + 1)
+	}
+
+	estimate := math.Ceil((float64(to) - float64(from)) / float64(step))
+	if !(estimate < math.MaxInt) {
+		return -1
+	}
+
+	count := max(int(estimate), 1)
+	for count > 1 && !within(from+T(count-1)*step) {
+		count--
+	}
+
+	for within(from + T(count)*step) {
+		count++
+	}
+
+	return count
+}
+
+type rangeIterer[T constraints.Integer | constraints.Float] struct {
+	from  T
+	step  T
+	count int
+}
+
+func (itr *rangeIterer[T]) Iter() Iter[T] {
+	return &rangeIter[T]{
+		from:  itr.from,
+		step:  itr.step,
+		count: itr.count,
+	}
+}
+
+func (itr *rangeIterer[T]) Len() int {
+	return itr.count
+}
 
+type rangeIter[T constraints.Integer | constraints.Float] struct {
+	closeGuard
+
+	from  T
+	step  T
+	count int
+	pos   int
+}
+
+func (it *rangeIter[T]) Next() (T, bool) {
+	if it.closed || (it.count >= 0 && it.pos >= it.count) {
 		var def T
 		return def, false
-	})
+	}
+
+	value := it.from + T(it.pos)*it.step
+	it.pos++
+	return value, true
+}
+
+func (it *rangeIter[T]) Close() error {
+	it.closed = true
+	return nil
 }
 
 func Repeat[T any](value T, count int) Iterer[T] {
-	i := 0
-	return Generate(func() (T, bool) {
-		if i >= count {
-			var def T
-			return def, false
-		}
+	return &repeatIterer[T]{
+		value: value,
+		count: max(count, 0),
+	}
+}
+
+type repeatIterer[T any] struct {
+	value T
+	count int
+}
+
+func (itr *repeatIterer[T]) Iter() Iter[T] {
+	return &repeatIter[T]{
+		value: itr.value,
+		count: itr.count,
+	}
+}
+
+func (itr *repeatIterer[T]) Len() int {
+	return itr.count
+}
+
+type repeatIter[T any] struct {
+	closeGuard
+
+	value T
+	count int
+	pos   int
+}
+
+func (it *repeatIter[T]) Next() (T, bool) {
+	if it.closed || it.pos >= it.count {
+		var def T
+		return def, false
+	}
+
+	it.pos++
+	return it.value, true
+}
+
+func (it *repeatIter[T]) Close() error {
+	it.closed = true
+	return nil
+}
 
-		i++
-		return value, true
+func Unfold[T, State any](seed State, unfolder func(State) (T, State, bool)) Iterer[T] {
+	return ItererFunc[T](func() Iter[T] {
+		return &unfoldIter[T, State]{
+			state:    seed,
+			unfolder: unfolder,
+		}
 	})
 }
+
+type unfoldIter[T, State any] struct {
+	closeGuard
+
+	state    State
+	unfolder func(State) (T, State, bool)
+	done     bool
+}
+
+func (it *unfoldIter[T, State]) Next() (T, bool) {
+	if it.closed || it.done {
+		var def T
+		return def, false
+	}
+
+	value, state, ok := it.unfolder(it.state)
+	if !ok {
+		it.done = true
+		var def T
+		return def, false
+	}
+
+	it.state = state
+	return value, true
+}
+
+func (it *unfoldIter[T, State]) Close() error {
+	it.closed = true
+	return nil
+}
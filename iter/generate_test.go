@@ -0,0 +1,381 @@
+package iter_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestCycle(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replays source", func(t *testing.T) {
+		src := iter.Cycle(iter.FromSlice([]int{1, 2, 3}))
+
+		actual, err := iter.ToSlice(iter.Take(src, 7))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 2, 3, 1, 2, 3, 1}, actual)
+
+		actual, err = iter.ToSlice(iter.Take(src, 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 2}, actual)
+	})
+
+	t.Run("empty source", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.Cycle(iter.FromSlice[int](nil)))
+		must.NoError(t, err)
+		must.Nil(t, actual)
+	})
+
+	t.Run("stops on close error", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		_, err := iter.ToSlice(iter.Cycle(iter.Concat(iter.FromSlice([]int{1}), iter.Err[int](errBoom))))
+		must.ErrorIs(t, err, errBoom)
+	})
+}
+
+func TestCycleN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		src      []int
+		count    int
+		expected []int
+	}{
+		{
+			name:     "twice",
+			src:      []int{1, 2},
+			count:    2,
+			expected: []int{1, 2, 1, 2},
+		},
+		{
+			name:     "once",
+			src:      []int{1, 2},
+			count:    1,
+			expected: []int{1, 2},
+		},
+		{
+			name:     "zero",
+			src:      []int{1, 2},
+			count:    0,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			src:      nil,
+			count:    3,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(iter.CycleN(iter.FromSlice(tc.src), tc.count))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestIterate(t *testing.T) {
+	t.Parallel()
+
+	src := iter.Iterate(1, func(v int) int { return v * 2 })
+
+	actual, err := iter.ToSlice(iter.Take(src, 5))
+	must.NoError(t, err)
+	must.Eq(t, []int{1, 2, 4, 8, 16}, actual)
+
+	actual, err = iter.ToSlice(iter.Take(src, 3))
+	must.NoError(t, err)
+	must.Eq(t, []int{1, 2, 4}, actual)
+}
+
+func TestUnfold(t *testing.T) {
+	t.Parallel()
+
+	type fib struct {
+		a, b int
+	}
+
+	src := iter.Unfold(fib{0, 1}, func(state fib) (int, fib, bool) {
+		if state.a > 20 {
+			return 0, state, false
+		}
+
+		return state.a, fib{state.b, state.a + state.b}, true
+	})
+
+	actual, err := iter.ToSlice(src)
+	must.NoError(t, err)
+	must.Eq(t, []int{0, 1, 1, 2, 3, 5, 8, 13}, actual)
+
+	actual, err = iter.ToSlice(src)
+	must.NoError(t, err)
+	must.Eq(t, []int{0, 1, 1, 2, 3, 5, 8, 13}, actual)
+}
+
+func TestLinspace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		from     float64
+		to       float64
+		count    int
+		expected []float64
+	}{
+		{
+			name:     "ascending",
+			from:     0,
+			to:       1,
+			count:    5,
+			expected: []float64{0, 0.25, 0.5, 0.75, 1},
+		},
+		{
+			name:     "descending",
+			from:     1,
+			to:       -1,
+			count:    3,
+			expected: []float64{1, 0, -1},
+		},
+		{
+			name:     "single",
+			from:     2,
+			to:       3,
+			count:    1,
+			expected: []float64{2},
+		},
+		{
+			name:     "empty",
+			from:     2,
+			to:       3,
+			count:    0,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := iter.Linspace(tc.from, tc.to, tc.count)
+
+			actual, err := iter.ToSlice(src)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(src)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+
+	t.Run("exact endpoints", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.Linspace(0.1, 0.7, 7))
+		must.NoError(t, err)
+		must.Eq(t, 0.1, actual[0])
+		must.Eq(t, 0.7, actual[6])
+	})
+}
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		from     int
+		to       int
+		step     int
+		expected []int
+	}{
+		{
+			name:     "ascending",
+			from:     0,
+			to:       5,
+			step:     2,
+			expected: []int{0, 2, 4},
+		},
+		{
+			name:     "ascending exact",
+			from:     0,
+			to:       6,
+			step:     2,
+			expected: []int{0, 2, 4},
+		},
+		{
+			name:     "descending",
+			from:     5,
+			to:       0,
+			step:     -2,
+			expected: []int{5, 3, 1},
+		},
+		{
+			name:     "wrong direction",
+			from:     5,
+			to:       0,
+			step:     1,
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			from:     3,
+			to:       3,
+			step:     1,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := iter.Range(tc.from, tc.to, tc.step)
+
+			actual, err := iter.ToSlice(src)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(src)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+
+	t.Run("restartable", func(t *testing.T) {
+		src := iter.Range(0, 5, 1)
+
+		first, err := iter.ToSlice(iter.Take(src, 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{0, 1}, first)
+
+		second, err := iter.ToSlice(src)
+		must.NoError(t, err)
+		must.Eq(t, []int{0, 1, 2, 3, 4}, second)
+	})
+
+	t.Run("overflowing distance", func(t *testing.T) {
+		length, err := iter.Len(iter.Range[int8](-100, 100, 50))
+		must.NoError(t, err)
+		must.Eq(t, 4, length)
+
+		actual, err := iter.ToSlice(iter.Range[int8](100, -100, -50))
+		must.NoError(t, err)
+		must.Eq(t, []int8{100, 50, 0, -50}, actual)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.Range(0, 1, 0.1))
+		must.NoError(t, err)
+		must.Len(t, 10, actual)
+		must.Eq(t, 0.9, actual[9])
+	})
+
+	t.Run("infinite", func(t *testing.T) {
+		src := iter.Range(0.0, math.Inf(1), 1.5)
+		_, sized := src.(interface{ Len() int })
+		must.False(t, sized)
+
+		actual, err := iter.ToSlice(iter.Take(src, 4))
+		must.NoError(t, err)
+		must.Eq(t, []float64{0, 1.5, 3, 4.5}, actual)
+
+		actual, err = iter.ToSlice(iter.Take(iter.Range(0.0, math.Inf(-1), -1), 2))
+		must.NoError(t, err)
+		must.Eq(t, []float64{0, -1}, actual)
+
+		actual, err = iter.ToSlice(iter.Range(0.0, math.Inf(-1), 1))
+		must.NoError(t, err)
+		must.Nil(t, actual)
+	})
+
+	t.Run("zero step", func(t *testing.T) {
+		defer func() {
+			must.NotNil(t, recover())
+		}()
+
+		iter.Range(0, 5, 0)
+	})
+}
+
+func TestRangeInclusive(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		from     float64
+		to       float64
+		step     float64
+		expected []float64
+	}{
+		{
+			name:     "ascending",
+			from:     0,
+			to:       1,
+			step:     0.25,
+			expected: []float64{0, 0.25, 0.5, 0.75, 1},
+		},
+		{
+			name:     "descending",
+			from:     1,
+			to:       0,
+			step:     -0.5,
+			expected: []float64{1, 0.5, 0},
+		},
+		{
+			name:     "single",
+			from:     2,
+			to:       2,
+			step:     1,
+			expected: []float64{2},
+		},
+		{
+			name:     "wrong direction",
+			from:     0,
+			to:       1,
+			step:     -1,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := iter.RangeInclusive(tc.from, tc.to, tc.step)
+
+			actual, err := iter.ToSlice(src)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(src)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	t.Parallel()
+
+	src := iter.Repeat("a", 3)
+
+	actual, err := iter.ToSlice(src)
+	must.NoError(t, err)
+	must.Eq(t, []string{"a", "a", "a"}, actual)
+
+	actual, err = iter.ToSlice(src)
+	must.NoError(t, err)
+	must.Eq(t, []string{"a", "a", "a"}, actual)
+
+	length, err := iter.Len(src)
+	must.NoError(t, err)
+	must.Eq(t, 3, length)
+
+	actual, err = iter.ToSlice(iter.Repeat("a", -1))
+	must.NoError(t, err)
+	must.Nil(t, actual)
+}
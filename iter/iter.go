@@ -14,3 +14,35 @@ type Iter[T any] interface {
 	Next() (T, bool)
 	Close() error
 }
+
+type closeGuard struct {
+	closed   bool
+	closeErr error
+}
+
+func (g *closeGuard) close(closer func() error) error {
+	if !g.closed {
+		g.closed = true
+		g.closeErr = closer()
+	}
+
+	return g.closeErr
+}
+
+func closeAll[S any](its []Iter[S]) error {
+	errs := make([]error, len(its))
+	for i, it := range its {
+		errs[i] = it.Close()
+	}
+
+	return combineErrors(errs...)
+}
+
+func iterAll[S any](srcs []Iterer[S]) []Iter[S] {
+	its := make([]Iter[S], len(srcs))
+	for i, src := range srcs {
+		its[i] = src.Iter()
+	}
+
+	return its
+}
@@ -0,0 +1,211 @@
+package iter
+
+import "slices"
+
+func FullOuterJoin[O, I any, K comparable, R any](
+	outer Iterer[O],
+	inner Iterer[I],
+	outerKeySelector func(O) K,
+	innerKeySelector func(I) K,
+	resultSelector func(O, bool, I, bool) R,
+) Iterer[R] {
+	return SelectMany(
+		joinGroups(outer, inner, outerKeySelector, innerKeySelector, true),
+		func(g joinGroup[O, I]) Iterer[R] {
+			var defO O
+			var defI I
+
+			if !g.hasOuter {
+				results := make([]R, len(g.inners))
+				for i, elem := range g.inners {
+					results[i] = resultSelector(defO, false, elem, true)
+				}
+
+				return FromSlice(results)
+			}
+
+			if len(g.inners) == 0 {
+				return FromSlice([]R{resultSelector(g.outer, true, defI, false)})
+			}
+
+			results := make([]R, len(g.inners))
+			for i, elem := range g.inners {
+				results[i] = resultSelector(g.outer, true, elem, true)
+			}
+
+			return FromSlice(results)
+		},
+	)
+}
+
+func GroupJoin[O, I any, K comparable, R any](
+	outer Iterer[O],
+	inner Iterer[I],
+	outerKeySelector func(O) K,
+	innerKeySelector func(I) K,
+	resultSelector func(O, []I) R,
+) Iterer[R] {
+	return Select(
+		joinGroups(outer, inner, outerKeySelector, innerKeySelector, false),
+		func(g joinGroup[O, I]) R {
+			return resultSelector(g.outer, slices.Clone(g.inners))
+		},
+	)
+}
+
+func Join[O, I any, K comparable, R any](
+	outer Iterer[O],
+	inner Iterer[I],
+	outerKeySelector func(O) K,
+	innerKeySelector func(I) K,
+	resultSelector func(O, I) R,
+) Iterer[R] {
+	return SelectMany(
+		joinGroups(outer, inner, outerKeySelector, innerKeySelector, false),
+		func(g joinGroup[O, I]) Iterer[R] {
+			results := make([]R, len(g.inners))
+			for i, elem := range g.inners {
+				results[i] = resultSelector(g.outer, elem)
+			}
+
+			return FromSlice(results)
+		},
+	)
+}
+
+func LeftJoin[O, I any, K comparable, R any](
+	outer Iterer[O],
+	inner Iterer[I],
+	outerKeySelector func(O) K,
+	innerKeySelector func(I) K,
+	resultSelector func(O, I, bool) R,
+) Iterer[R] {
+	return SelectMany(
+		joinGroups(outer, inner, outerKeySelector, innerKeySelector, false),
+		func(g joinGroup[O, I]) Iterer[R] {
+			if len(g.inners) == 0 {
+				var def I
+				return FromSlice([]R{resultSelector(g.outer, def, false)})
+			}
+
+			results := make([]R, len(g.inners))
+			for i, elem := range g.inners {
+				results[i] = resultSelector(g.outer, elem, true)
+			}
+
+			return FromSlice(results)
+		},
+	)
+}
+
+type joinGroup[O, I any] struct {
+	outer    O
+	hasOuter bool
+	inners   []I
+}
+
+func joinGroups[O, I any, K comparable](
+	outer Iterer[O],
+	inner Iterer[I],
+	outerKeySelector func(O) K,
+	innerKeySelector func(I) K,
+	unmatched bool,
+) Iterer[joinGroup[O, I]] {
+	return ItererFunc[joinGroup[O, I]](func() Iter[joinGroup[O, I]] {
+		return &joinIter[O, I, K]{
+			outer:            outer.Iter(),
+			inner:            inner,
+			outerKeySelector: outerKeySelector,
+			innerKeySelector: innerKeySelector,
+			unmatched:        unmatched,
+		}
+	})
+}
+
+type joinIter[O, I any, K comparable] struct {
+	closeGuard
+
+	outer            Iter[O]
+	inner            Iterer[I]
+	outerKeySelector func(O) K
+	innerKeySelector func(I) K
+	unmatched        bool
+
+	lookup  map[K][]I
+	keys    []K
+	matched map[K]struct{}
+
+	built     bool
+	outerDone bool
+	pos       int
+	err       error
+}
+
+func (it *joinIter[O, I, K]) Next() (joinGroup[O, I], bool) {
+	if it.closed || it.err != nil {
+		return joinGroup[O, I]{}, false
+	}
+
+	if !it.built {
+		it.built = true
+		if it.err = it.build(); it.err != nil {
+			return joinGroup[O, I]{}, false
+		}
+	}
+
+	if !it.outerDone {
+		elem, ok := it.outer.Next()
+		if ok {
+			key := it.outerKeySelector(elem)
+			inners := it.lookup[key]
+			if it.unmatched && len(inners) > 0 {
+				it.matched[key] = struct{}{}
+			}
+
+			return joinGroup[O, I]{outer: elem, hasOuter: true, inners: inners}, true
+		}
+
+		it.outerDone = true
+	}
+
+	if it.unmatched {
+		for it.pos < len(it.keys) {
+			key := it.keys[it.pos]
+			it.pos++
+			if _, ok := it.matched[key]; !ok {
+				return joinGroup[O, I]{inners: it.lookup[key]}, true
+			}
+		}
+	}
+
+	return joinGroup[O, I]{}, false
+}
+
+func (it *joinIter[O, I, K]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.err, it.outer.Close())
+	})
+}
+
+func (it *joinIter[O, I, K]) build() (err error) {
+	in := it.inner.Iter()
+	defer func() {
+		err = in.Close()
+	}()
+
+	it.lookup = make(map[K][]I)
+	if it.unmatched {
+		it.matched = make(map[K]struct{})
+	}
+
+	for elem, ok := in.Next(); ok; elem, ok = in.Next() {
+		key := it.innerKeySelector(elem)
+		if _, ok := it.lookup[key]; !ok && it.unmatched {
+			it.keys = append(it.keys, key)
+		}
+
+		it.lookup[key] = append(it.lookup[key], elem)
+	}
+
+	return
+}
@@ -0,0 +1,166 @@
+package iter_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+type order struct {
+	customer string
+	item     string
+}
+
+var (
+	customers = []string{"alice", "bob", "carol"}
+	orders    = []order{
+		{"alice", "apple"},
+		{"carol", "cherry"},
+		{"alice", "avocado"},
+		{"dave", "date"},
+	}
+)
+
+func orderCustomer(o order) string { return o.customer }
+func identity[T any](v T) T        { return v }
+
+func TestFullOuterJoin(t *testing.T) {
+	t.Parallel()
+
+	actual, err := iter.ToSlice(iter.FullOuterJoin(
+		iter.FromSlice(customers),
+		iter.FromSlice(orders),
+		identity[string],
+		orderCustomer,
+		func(c string, hasCustomer bool, o order, hasOrder bool) string {
+			return fmt.Sprintf("%v:%s/%v:%s", hasCustomer, c, hasOrder, o.item)
+		},
+	))
+	must.NoError(t, err)
+	must.Eq(t, []string{
+		"true:alice/true:apple",
+		"true:alice/true:avocado",
+		"true:bob/false:",
+		"true:carol/true:cherry",
+		"false:/true:date",
+	}, actual)
+}
+
+func TestGroupJoin(t *testing.T) {
+	t.Parallel()
+
+	actual, err := iter.ToSlice(iter.GroupJoin(
+		iter.FromSlice(customers),
+		iter.FromSlice(orders),
+		identity[string],
+		orderCustomer,
+		func(c string, os []order) string {
+			items := make([]string, len(os))
+			for i, o := range os {
+				items[i] = o.item
+			}
+			return c + "=" + strings.Join(items, ",")
+		},
+	))
+	must.NoError(t, err)
+	must.Eq(t, []string{"alice=apple,avocado", "bob=", "carol=cherry"}, actual)
+}
+
+func TestJoin(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		outer    []string
+		inner    []order
+		expected []string
+	}{
+		{
+			name:     "matches",
+			outer:    customers,
+			inner:    orders,
+			expected: []string{"alice:apple", "alice:avocado", "carol:cherry"},
+		},
+		{
+			name:     "empty inner",
+			outer:    customers,
+			inner:    nil,
+			expected: nil,
+		},
+		{
+			name:     "empty outer",
+			outer:    nil,
+			inner:    orders,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(iter.Join(
+				iter.FromSlice(tc.outer),
+				iter.FromSlice(tc.inner),
+				identity[string],
+				orderCustomer,
+				func(c string, o order) string { return c + ":" + o.item },
+			))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("inner error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		inner := iter.Concat(iter.FromSlice(orders), iter.Err[order](expectedErr))
+
+		actual, err := iter.ToSlice(iter.Join(
+			iter.FromSlice(customers),
+			inner,
+			identity[string],
+			orderCustomer,
+			func(c string, o order) string { return c + ":" + o.item },
+		))
+		must.ErrorIs(t, err, expectedErr)
+		must.Nil(t, actual)
+	})
+
+	t.Run("outer error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		outer := iter.Concat(iter.FromSlice(customers), iter.Err[string](expectedErr))
+
+		actual, err := iter.ToSlice(iter.Join(
+			outer,
+			iter.FromSlice(orders),
+			identity[string],
+			orderCustomer,
+			func(c string, o order) string { return c + ":" + o.item },
+		))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, []string{"alice:apple", "alice:avocado", "carol:cherry"}, actual)
+	})
+}
+
+func TestLeftJoin(t *testing.T) {
+	t.Parallel()
+
+	actual, err := iter.ToSlice(iter.LeftJoin(
+		iter.FromSlice(customers),
+		iter.FromSlice(orders),
+		identity[string],
+		orderCustomer,
+		func(c string, o order, ok bool) string {
+			if !ok {
+				return c + ":none"
+			}
+			return c + ":" + o.item
+		},
+	))
+	must.NoError(t, err)
+	must.Eq(t, []string{"alice:apple", "alice:avocado", "bob:none", "carol:cherry"}, actual)
+}
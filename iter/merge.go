@@ -0,0 +1,132 @@
+package iter
+
+import (
+	"cmp"
+	"container/heap"
+
+	"golang.org/x/exp/constraints"
+)
+
+func Merge[S constraints.Ordered](srcs []Iterer[S], opts ...MergeOpt) Iterer[S] {
+	return MergeBy(srcs, identity[S], opts...)
+}
+
+func MergeBy[S any, K constraints.Ordered](srcs []Iterer[S], keySelector func(S) K, opts ...MergeOpt) Iterer[S] {
+	var o mergeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return ItererFunc[S](func() Iter[S] {
+		its := make([]Iter[S], len(srcs))
+		for i, src := range srcs {
+			its[i] = src.Iter()
+		}
+
+		return &mergeIter[S, K]{
+			srcs:        its,
+			keySelector: keySelector,
+			opts:        o,
+		}
+	})
+}
+
+type mergeIter[S any, K constraints.Ordered] struct {
+	closeGuard
+
+	srcs        []Iter[S]
+	keySelector func(S) K
+	opts        mergeOptions
+
+	heap    mergeHeap[S, K]
+	started bool
+	lastKey K
+	hasLast bool
+}
+
+func (it *mergeIter[S, K]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	if !it.started {
+		it.started = true
+		it.heap.descending = it.opts.descending
+		for i := range it.srcs {
+			if item, ok := it.pull(i); ok {
+				it.heap.items = append(it.heap.items, item)
+			}
+		}
+		heap.Init(&it.heap)
+	}
+
+	for it.heap.Len() > 0 {
+		item := it.heap.items[0]
+		if next, ok := it.pull(item.src); ok {
+			it.heap.items[0] = next
+			heap.Fix(&it.heap, 0)
+		} else {
+			heap.Pop(&it.heap)
+		}
+
+		if it.opts.distinct && it.hasLast && item.key == it.lastKey {
+			continue
+		}
+
+		it.lastKey, it.hasLast = item.key, true
+		return item.value, true
+	}
+
+	var def S
+	return def, false
+}
+
+func (it *mergeIter[S, K]) Close() error {
+	return it.close(func() error {
+		return closeAll(it.srcs)
+	})
+}
+
+func (it *mergeIter[S, K]) pull(src int) (mergeItem[S, K], bool) {
+	value, ok := it.srcs[src].Next()
+	if !ok {
+		return mergeItem[S, K]{}, false
+	}
+
+	return mergeItem[S, K]{value: value, key: it.keySelector(value), src: src}, true
+}
+
+type mergeItem[S any, K constraints.Ordered] struct {
+	value S
+	key   K
+	src   int
+}
+
+type mergeHeap[S any, K constraints.Ordered] struct {
+	items      []mergeItem[S, K]
+	descending bool
+}
+
+func (h *mergeHeap[S, K]) Len() int { return len(h.items) }
+
+func (h *mergeHeap[S, K]) Less(i, j int) bool {
+	c := cmp.Compare(h.items[i].key, h.items[j].key)
+	if h.descending {
+		c = -c
+	}
+	if c != 0 {
+		return c < 0
+	}
+
+	return h.items[i].src < h.items[j].src
+}
+
+func (h *mergeHeap[S, K]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *mergeHeap[S, K]) Push(x any)    { h.items = append(h.items, x.(mergeItem[S, K])) }
+
+func (h *mergeHeap[S, K]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
@@ -0,0 +1,105 @@
+package iter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		inputs   [][]int
+		opts     []iter.MergeOpt
+		expected []int
+	}{
+		{
+			name:     "ascending",
+			inputs:   [][]int{{1, 4, 7}, {2, 5, 8}, {3, 6, 9}},
+			expected: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+		{
+			name:     "uneven",
+			inputs:   [][]int{{1, 10}, nil, {2, 3, 4, 5}},
+			expected: []int{1, 2, 3, 4, 5, 10},
+		},
+		{
+			name:     "duplicates kept",
+			inputs:   [][]int{{1, 3, 3}, {3, 4}},
+			expected: []int{1, 3, 3, 3, 4},
+		},
+		{
+			name:     "distinct",
+			inputs:   [][]int{{1, 3, 3}, {3, 4}},
+			opts:     []iter.MergeOpt{iter.WithDistinct()},
+			expected: []int{1, 3, 4},
+		},
+		{
+			name:     "descending",
+			inputs:   [][]int{{9, 5, 1}, {8, 2}},
+			opts:     []iter.MergeOpt{iter.WithDescending()},
+			expected: []int{9, 8, 5, 2, 1},
+		},
+		{
+			name:     "none",
+			inputs:   nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			srcs := make([]iter.Iterer[int], len(tc.inputs))
+			for i, input := range tc.inputs {
+				srcs[i] = iter.FromSlice(input)
+			}
+
+			actual, err := iter.ToSlice(iter.Merge(srcs, tc.opts...))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("errors from every input", func(t *testing.T) {
+		err1 := errors.New("first")
+		err2 := errors.New("second")
+		srcs := []iter.Iterer[int]{
+			iter.Concat(iter.FromSlice([]int{1}), iter.Err[int](err1)),
+			iter.FromSlice([]int{2}),
+			iter.Err[int](err2),
+		}
+
+		actual, err := iter.ToSlice(iter.Merge(srcs))
+		must.ErrorIs(t, err, err1)
+		must.ErrorIs(t, err, err2)
+		must.Eq(t, []int{1, 2}, actual)
+	})
+}
+
+func TestMergeBy(t *testing.T) {
+	t.Parallel()
+
+	type entry struct {
+		ts    int
+		shard string
+	}
+
+	srcs := []iter.Iterer[entry]{
+		iter.FromSlice([]entry{{1, "a"}, {3, "a"}}),
+		iter.FromSlice([]entry{{1, "b"}, {2, "b"}}),
+	}
+
+	actual, err := iter.ToSlice(iter.MergeBy(srcs, func(e entry) int { return e.ts }))
+	must.NoError(t, err)
+	must.Eq(t, []entry{{1, "a"}, {1, "b"}, {2, "b"}, {3, "a"}}, actual)
+
+	actual, err = iter.ToSlice(iter.MergeBy(srcs, func(e entry) int { return e.ts }, iter.WithDistinct()))
+	must.NoError(t, err)
+	must.Eq(t, []entry{{1, "a"}, {2, "b"}, {3, "a"}}, actual)
+}
@@ -0,0 +1,92 @@
+package iter
+
+type parallelOptions struct {
+	workers    int
+	bufferSize int
+	unordered  bool
+}
+
+type ParallelOpt func(*parallelOptions)
+
+func WithBufferSize(size int) ParallelOpt {
+	return func(o *parallelOptions) {
+		o.bufferSize = size
+	}
+}
+
+func WithUnordered() ParallelOpt {
+	return func(o *parallelOptions) {
+		o.unordered = true
+	}
+}
+
+func WithWorkers(workers int) ParallelOpt {
+	return func(o *parallelOptions) {
+		o.workers = workers
+	}
+}
+
+type chanOptions struct {
+	drainOnClose bool
+}
+
+type ChanOpt func(*chanOptions)
+
+// WithDrainOnClose makes Close receive and discard the remaining values so a
+// blocked producer can finish. Close returns only once the channel is closed, so
+// the producer must close it.
+func WithDrainOnClose() ChanOpt {
+	return func(o *chanOptions) {
+		o.drainOnClose = true
+	}
+}
+
+type tryOptions struct {
+	continueOnError bool
+}
+
+type TryOpt func(*tryOptions)
+
+func WithContinueOnError() TryOpt {
+	return func(o *tryOptions) {
+		o.continueOnError = true
+	}
+}
+
+type mergeOptions struct {
+	descending bool
+	distinct   bool
+}
+
+type MergeOpt func(*mergeOptions)
+
+func WithDescending() MergeOpt {
+	return func(o *mergeOptions) {
+		o.descending = true
+	}
+}
+
+func WithDistinct() MergeOpt {
+	return func(o *mergeOptions) {
+		o.distinct = true
+	}
+}
+
+type readerOptions struct {
+	maxLineLength int
+	closeReader   bool
+}
+
+type ReaderOpt func(*readerOptions)
+
+func WithCloseReader() ReaderOpt {
+	return func(o *readerOptions) {
+		o.closeReader = true
+	}
+}
+
+func WithMaxLineLength(length int) ReaderOpt {
+	return func(o *readerOptions) {
+		o.maxLineLength = length
+	}
+}
@@ -0,0 +1,197 @@
+package iter
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+func OrderBy[S any, K constraints.Ordered](src Iterer[S], keySelector func(S) K) *OrderedIterer[S] {
+	return OrderByFunc(src, keyComparer(keySelector, false))
+}
+
+func OrderByDescending[S any, K constraints.Ordered](src Iterer[S], keySelector func(S) K) *OrderedIterer[S] {
+	return OrderByFunc(src, keyComparer(keySelector, true))
+}
+
+func OrderByFunc[S any](src Iterer[S], comparer func(S, S) int) *OrderedIterer[S] {
+	return &OrderedIterer[S]{
+		src:       src,
+		comparers: []func(S, S) int{comparer},
+	}
+}
+
+func ThenBy[S any, K constraints.Ordered](src *OrderedIterer[S], keySelector func(S) K) *OrderedIterer[S] {
+	return ThenByFunc(src, keyComparer(keySelector, false))
+}
+
+func ThenByDescending[S any, K constraints.Ordered](src *OrderedIterer[S], keySelector func(S) K) *OrderedIterer[S] {
+	return ThenByFunc(src, keyComparer(keySelector, true))
+}
+
+func ThenByFunc[S any](src *OrderedIterer[S], comparer func(S, S) int) *OrderedIterer[S] {
+	comparers := make([]func(S, S) int, 0, len(src.comparers)+1)
+	comparers = append(comparers, src.comparers...)
+	comparers = append(comparers, comparer)
+
+	return &OrderedIterer[S]{
+		src:       src.src,
+		comparers: comparers,
+	}
+}
+
+func keyComparer[S any, K constraints.Ordered](keySelector func(S) K, descending bool) func(S, S) int {
+	if descending {
+		return func(a, b S) int {
+			return cmp.Compare(keySelector(b), keySelector(a))
+		}
+	}
+
+	return func(a, b S) int {
+		return cmp.Compare(keySelector(a), keySelector(b))
+	}
+}
+
+type OrderedIterer[S any] struct {
+	src       Iterer[S]
+	comparers []func(S, S) int
+}
+
+func (itr *OrderedIterer[S]) Iter() Iter[S] {
+	return &orderedIter[S]{
+		src:     itr.src.Iter(),
+		compare: itr.compare,
+		limit:   -1,
+	}
+}
+
+func (itr *OrderedIterer[S]) compare(a, b S) int {
+	for _, comparer := range itr.comparers {
+		if c := comparer(a, b); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+func (itr *OrderedIterer[S]) take(limit int) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &orderedIter[S]{
+			src:     itr.src.Iter(),
+			compare: itr.compare,
+			limit:   max(limit, 0),
+		}
+	})
+}
+
+type orderedIter[S any] struct {
+	closeGuard
+
+	src     Iter[S]
+	compare func(S, S) int
+	limit   int
+
+	values []S
+	built  bool
+	pos    int
+}
+
+func (it *orderedIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	if !it.built {
+		it.built = true
+		it.build()
+	}
+
+	if it.pos >= len(it.values) {
+		var def S
+		return def, false
+	}
+
+	it.pos++
+	return it.values[it.pos-1], true
+}
+
+func (it *orderedIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func (it *orderedIter[S]) build() {
+	switch {
+	case it.limit < 0:
+		for elem, ok := it.src.Next(); ok; elem, ok = it.src.Next() {
+			it.values = append(it.values, elem)
+		}
+
+		slices.SortStableFunc(it.values, it.compare)
+	case it.limit > 0:
+		it.values = it.top()
+	}
+}
+
+func (it *orderedIter[S]) top() []S {
+	h := &topHeap[S]{compare: it.compare}
+	idx := 0
+	for elem, ok := it.src.Next(); ok; elem, ok = it.src.Next() {
+		item := topItem[S]{value: elem, idx: idx}
+		idx++
+
+		if len(h.items) < it.limit {
+			heap.Push(h, item)
+		} else if h.less(item, h.items[0]) {
+			h.items[0] = item
+			heap.Fix(h, 0)
+		}
+	}
+
+	slices.SortFunc(h.items, func(a, b topItem[S]) int {
+		if c := it.compare(a.value, b.value); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.idx, b.idx)
+	})
+
+	result := make([]S, len(h.items))
+	for i, item := range h.items {
+		result[i] = item.value
+	}
+
+	return result
+}
+
+type topItem[S any] struct {
+	value S
+	idx   int
+}
+
+type topHeap[S any] struct {
+	items   []topItem[S]
+	compare func(S, S) int
+}
+
+func (h *topHeap[S]) less(a, b topItem[S]) bool {
+	if c := h.compare(a.value, b.value); c != 0 {
+		return c < 0
+	}
+
+	return a.idx < b.idx
+}
+
+func (h *topHeap[S]) Len() int           { return len(h.items) }
+func (h *topHeap[S]) Less(i, j int) bool { return h.less(h.items[j], h.items[i]) }
+func (h *topHeap[S]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *topHeap[S]) Push(x any)         { h.items = append(h.items, x.(topItem[S])) }
+
+func (h *topHeap[S]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
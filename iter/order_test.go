@@ -0,0 +1,151 @@
+package iter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+var people = []person{
+	{"carol", 35},
+	{"alice", 30},
+	{"bob", 25},
+	{"dave", 30},
+	{"erin", 25},
+}
+
+func TestOrderBy(t *testing.T) {
+	t.Parallel()
+
+	byAge := func(p person) int { return p.age }
+	byName := func(p person) string { return p.name }
+
+	testCases := []struct {
+		name     string
+		input    iter.Iterer[person]
+		expected []person
+	}{
+		{
+			name:     "ascending is stable",
+			input:    iter.OrderBy(iter.FromSlice(people), byAge),
+			expected: []person{{"bob", 25}, {"erin", 25}, {"alice", 30}, {"dave", 30}, {"carol", 35}},
+		},
+		{
+			name:     "descending is stable",
+			input:    iter.OrderByDescending(iter.FromSlice(people), byAge),
+			expected: []person{{"carol", 35}, {"alice", 30}, {"dave", 30}, {"bob", 25}, {"erin", 25}},
+		},
+		{
+			name:     "then by descending",
+			input:    iter.ThenByDescending(iter.OrderBy(iter.FromSlice(people), byAge), byName),
+			expected: []person{{"erin", 25}, {"bob", 25}, {"dave", 30}, {"alice", 30}, {"carol", 35}},
+		},
+		{
+			name: "func",
+			input: iter.ThenBy(iter.OrderByFunc(iter.FromSlice(people), func(a, b person) int {
+				return strings.Compare(a.name[len(a.name)-1:], b.name[len(b.name)-1:])
+			}), byName),
+			expected: []person{{"bob", 25}, {"alice", 30}, {"dave", 30}, {"carol", 35}, {"erin", 25}},
+		},
+		{
+			name:     "take",
+			input:    iter.Take(iter.OrderBy(iter.FromSlice(people), byAge), 3),
+			expected: []person{{"bob", 25}, {"erin", 25}, {"alice", 30}},
+		},
+		{
+			name:     "take more than available",
+			input:    iter.Take(iter.OrderByDescending(iter.FromSlice(people), byAge), 10),
+			expected: []person{{"carol", 35}, {"alice", 30}, {"dave", 30}, {"bob", 25}, {"erin", 25}},
+		},
+		{
+			name:     "take none",
+			input:    iter.Take(iter.OrderBy(iter.FromSlice(people), byAge), 0),
+			expected: nil,
+		},
+		{
+			name:     "skip",
+			input:    iter.Skip(iter.OrderBy(iter.FromSlice(people), byAge), 3),
+			expected: []person{{"dave", 30}, {"carol", 35}},
+		},
+		{
+			name:     "empty",
+			input:    iter.OrderBy(iter.FromSlice[person](nil), byAge),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(tc.input)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("does not modify source", func(t *testing.T) {
+		input := []int{3, 1, 2}
+
+		actual, err := iter.ToSlice(iter.OrderBy(iter.FromSlice(input), func(i int) int { return i }))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 2, 3}, actual)
+		must.Eq(t, []int{3, 1, 2}, input)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{3, 1}), iter.Err[int](expectedErr))
+
+		_, err := iter.ToSlice(iter.OrderBy(src, func(i int) int { return i }))
+		must.ErrorIs(t, err, expectedErr)
+
+		_, err = iter.ToSlice(iter.Take(iter.OrderBy(src, func(i int) int { return i }), 1))
+		must.ErrorIs(t, err, expectedErr)
+	})
+
+	t.Run("reads source on first next", func(t *testing.T) {
+		src := &countingSource{values: []int{3, 1, 2}}
+		ordered := iter.OrderBy[int](src, func(i int) int { return i })
+
+		for _, it := range []iter.Iter[int]{ordered.Iter(), iter.Take[int](ordered, 2).Iter()} {
+			must.Eq(t, 0, src.reads)
+
+			value, ok := it.Next()
+			must.True(t, ok)
+			must.Eq(t, 1, value)
+			must.Eq(t, 3, src.reads)
+			must.NoError(t, it.Close())
+
+			src.reads = 0
+		}
+
+		must.Eq(t, 2, src.closes)
+	})
+
+	t.Run("take keeps a bounded heap", func(t *testing.T) {
+		const n = 1000
+
+		compares := 0
+		ordered := iter.OrderByFunc(iter.Range(0, n, 1), func(a, b int) int {
+			compares++
+			return b - a
+		})
+
+		actual, err := iter.ToSlice(iter.Take[int](ordered, 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{n - 1}, actual)
+
+		// A full sort needs at least log2(n!) comparisons, roughly 8500 here,
+		// while a heap of one element compares each value once.
+		must.Less(t, 2*n, compares)
+	})
+}
@@ -0,0 +1,221 @@
+package iter
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+func ParallelFilter[S any](src Iterer[S], filter func(S) bool, opts ...ParallelOpt) Iterer[S] {
+	return parallel(src, func(value S) (S, bool) {
+		return value, filter(value)
+	}, opts)
+}
+
+func ParallelSelect[S, R any](src Iterer[S], selector func(S) R, opts ...ParallelOpt) Iterer[R] {
+	return parallel(src, func(value S) (R, bool) {
+		return selector(value), true
+	}, opts)
+}
+
+func parallel[S, R any](src Iterer[S], fn func(S) (R, bool), opts []ParallelOpt) Iterer[R] {
+	var o parallelOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.workers <= 0 {
+		o.workers = runtime.GOMAXPROCS(0)
+	}
+	if o.bufferSize < o.workers {
+		o.bufferSize = o.workers
+	}
+
+	return ItererFunc[R](func() Iter[R] {
+		return &parallelIter[S, R]{
+			src:     src.Iter(),
+			fn:      fn,
+			opts:    o,
+			pending: make(map[int]parallelResult[R]),
+		}
+	})
+}
+
+type parallelJob[S any] struct {
+	idx   int
+	value S
+}
+
+type parallelResult[R any] struct {
+	idx   int
+	value R
+	keep  bool
+}
+
+type parallelIter[S, R any] struct {
+	closeGuard
+
+	src  Iter[S]
+	fn   func(S) (R, bool)
+	opts parallelOptions
+
+	tokens  chan struct{}
+	results chan parallelResult[R]
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	pending map[int]parallelResult[R]
+	next    int
+
+	started    bool
+	cancelOnce sync.Once
+	errMu      sync.Mutex
+	errs       []error
+}
+
+func (it *parallelIter[S, R]) Next() (R, bool) {
+	if it.closed {
+		var def R
+		return def, false
+	}
+
+	if !it.started {
+		it.start()
+	}
+
+	for {
+		if !it.opts.unordered {
+			if r, ok := it.pending[it.next]; ok {
+				delete(it.pending, it.next)
+				it.next++
+				<-it.tokens
+				if r.keep {
+					return r.value, true
+				}
+
+				continue
+			}
+		}
+
+		r, ok := <-it.results
+		if !ok {
+			var def R
+			return def, false
+		}
+
+		if it.opts.unordered {
+			<-it.tokens
+			if r.keep {
+				return r.value, true
+			}
+
+			continue
+		}
+
+		it.pending[r.idx] = r
+	}
+}
+
+func (it *parallelIter[S, R]) Close() error {
+	return it.close(func() error {
+		if !it.started {
+			return it.src.Close()
+		}
+
+		it.cancel()
+		it.wg.Wait()
+		return combineErrors(it.errs...)
+	})
+}
+
+func (it *parallelIter[S, R]) start() {
+	it.started = true
+	it.tokens = make(chan struct{}, it.opts.bufferSize)
+	it.results = make(chan parallelResult[R], it.opts.workers)
+	it.done = make(chan struct{})
+
+	jobs := make(chan parallelJob[S], it.opts.workers)
+
+	it.wg.Add(1)
+	go it.produce(jobs)
+
+	var workers sync.WaitGroup
+	workers.Add(it.opts.workers)
+	it.wg.Add(it.opts.workers)
+	for i := 0; i < it.opts.workers; i++ {
+		go func() {
+			defer it.wg.Done()
+			defer workers.Done()
+			it.work(jobs)
+		}()
+	}
+
+	go func() {
+		workers.Wait()
+		close(it.results)
+	}()
+}
+
+func (it *parallelIter[S, R]) produce(jobs chan<- parallelJob[S]) {
+	defer it.wg.Done()
+	defer close(jobs)
+	defer func() {
+		if err := it.src.Close(); err != nil {
+			it.addErr(err)
+		}
+	}()
+	defer it.recoverPanic()
+
+	for idx := 0; ; idx++ {
+		select {
+		case it.tokens <- struct{}{}:
+		case <-it.done:
+			return
+		}
+
+		value, ok := it.src.Next()
+		if !ok {
+			return
+		}
+
+		select {
+		case jobs <- parallelJob[S]{idx: idx, value: value}:
+		case <-it.done:
+			return
+		}
+	}
+}
+
+func (it *parallelIter[S, R]) work(jobs <-chan parallelJob[S]) {
+	defer it.recoverPanic()
+
+	for job := range jobs {
+		value, keep := it.fn(job.value)
+
+		select {
+		case it.results <- parallelResult[R]{idx: job.idx, value: value, keep: keep}:
+		case <-it.done:
+			return
+		}
+	}
+}
+
+func (it *parallelIter[S, R]) recoverPanic() {
+	if r := recover(); r != nil {
+		it.addErr(fmt.Errorf("%w: %v", ErrPanic, r))
+		it.cancel()
+	}
+}
+
+func (it *parallelIter[S, R]) cancel() {
+	it.cancelOnce.Do(func() {
+		close(it.done)
+	})
+}
+
+func (it *parallelIter[S, R]) addErr(err error) {
+	it.errMu.Lock()
+	defer it.errMu.Unlock()
+
+	it.errs = append(it.errs, err)
+}
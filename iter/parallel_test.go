@@ -0,0 +1,106 @@
+package iter_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestParallelFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		opts     []iter.ParallelOpt
+		expected []int
+	}{
+		{
+			name:     "default",
+			input:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expected: []int{2, 4, 6, 8},
+		},
+		{
+			name:     "single worker",
+			input:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			opts:     []iter.ParallelOpt{iter.WithWorkers(1)},
+			expected: []int{2, 4, 6, 8},
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.ParallelFilter(it, func(i int) bool { return i%2 == 0 }, tc.opts...))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestParallelSelect(t *testing.T) {
+	t.Parallel()
+
+	input, err := iter.ToSlice(iter.Range(0, 1000, 1))
+	must.NoError(t, err)
+
+	expected, err := iter.ToSlice(iter.Select(iter.FromSlice(input), func(i int) int { return i * 2 }))
+	must.NoError(t, err)
+
+	t.Run("ordered", func(t *testing.T) {
+		it := iter.ParallelSelect(iter.FromSlice(input), func(i int) int { return i * 2 }, iter.WithWorkers(8), iter.WithBufferSize(16))
+
+		actual, err := iter.ToSlice(it)
+		must.NoError(t, err)
+		must.Eq(t, expected, actual)
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		it := iter.ParallelSelect(iter.FromSlice(input), func(i int) int { return i * 2 }, iter.WithWorkers(8), iter.WithUnordered())
+
+		actual, err := iter.ToSlice(it)
+		must.NoError(t, err)
+		sort.Ints(actual)
+		must.Eq(t, expected, actual)
+	})
+
+	t.Run("early close", func(t *testing.T) {
+		it := iter.ParallelSelect(iter.FromSlice(input), func(i int) int { return i * 2 }, iter.WithWorkers(4))
+
+		actual, err := iter.ToSlice(iter.Take(it, 3))
+		must.NoError(t, err)
+		must.Eq(t, []int{0, 2, 4}, actual)
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		it := iter.ParallelSelect(iter.FromSlice(input), func(i int) int {
+			if i == 500 {
+				panic("boom")
+			}
+			return i
+		})
+
+		_, err := iter.ToSlice(it)
+		must.ErrorIs(t, err, iter.ErrPanic)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{1, 2, 3}), iter.Err[int](expectedErr))
+
+		actual, err := iter.ToSlice(iter.ParallelSelect(src, func(i int) int { return i * 2 }))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, []int{2, 4, 6}, actual)
+	})
+}
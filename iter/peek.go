@@ -0,0 +1,117 @@
+package iter
+
+var _ Iter[int] = (*Peekable[int])(nil)
+
+func NewPeekable[S any](it Iter[S]) *Peekable[S] {
+	return &Peekable[S]{
+		src: it,
+	}
+}
+
+type Peekable[S any] struct {
+	closeGuard
+
+	src     Iter[S]
+	buf     []S
+	head    int
+	srcDone bool
+}
+
+func (p *Peekable[S]) Close() error {
+	return p.close(func() error {
+		p.buf = nil
+		p.head = 0
+		return p.src.Close()
+	})
+}
+
+func (p *Peekable[S]) Next() (S, bool) {
+	if p.closed || !p.fill(1) {
+		var def S
+		return def, false
+	}
+
+	value := p.buf[p.head]
+	var def S
+	p.buf[p.head] = def
+	p.head++
+	if p.head == len(p.buf) {
+		p.buf = p.buf[:0]
+		p.head = 0
+	}
+
+	return value, true
+}
+
+func (p *Peekable[S]) NextIf(predicate func(S) bool) (S, bool) {
+	value, ok := p.Peek()
+	if !ok || !predicate(value) {
+		var def S
+		return def, false
+	}
+
+	return p.Next()
+}
+
+func (p *Peekable[S]) NextWhile(predicate func(S) bool) []S {
+	var result []S
+	for value, ok := p.NextIf(predicate); ok; value, ok = p.NextIf(predicate) {
+		result = append(result, value)
+	}
+
+	return result
+}
+
+func (p *Peekable[S]) Peek() (S, bool) {
+	if p.closed || !p.fill(1) {
+		var def S
+		return def, false
+	}
+
+	return p.buf[p.head], true
+}
+
+func (p *Peekable[S]) PeekN(n int) []S {
+	if p.closed || n <= 0 {
+		return nil
+	}
+
+	p.fill(n)
+
+	end := min(p.head+n, len(p.buf))
+	if end == p.head {
+		return nil
+	}
+
+	result := make([]S, end-p.head)
+	copy(result, p.buf[p.head:end])
+	return result
+}
+
+func (p *Peekable[S]) Unread(v S) {
+	if p.closed {
+		return
+	}
+
+	if p.head > 0 {
+		p.head--
+		p.buf[p.head] = v
+		return
+	}
+
+	p.buf = append([]S{v}, p.buf...)
+}
+
+func (p *Peekable[S]) fill(n int) bool {
+	for len(p.buf)-p.head < n && !p.srcDone {
+		value, ok := p.src.Next()
+		if !ok {
+			p.srcDone = true
+			break
+		}
+
+		p.buf = append(p.buf, value)
+	}
+
+	return len(p.buf)-p.head >= n
+}
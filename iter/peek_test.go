@@ -0,0 +1,101 @@
+package iter_test
+
+import (
+	"testing"
+	"unicode"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestPeekable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("peek does not advance", func(t *testing.T) {
+		p := iter.NewPeekable(iter.FromSlice([]int{1, 3, 5}).Iter())
+
+		v, ok := p.Peek()
+		must.True(t, ok)
+		must.Eq(t, 1, v)
+
+		v, ok = p.Next()
+		must.True(t, ok)
+		must.Eq(t, 1, v)
+
+		v, ok = p.Peek()
+		must.True(t, ok)
+		must.Eq(t, 3, v)
+		must.NoError(t, p.Close())
+	})
+
+	t.Run("peek n", func(t *testing.T) {
+		p := iter.NewPeekable(iter.FromSlice([]int{1, 3, 5}).Iter())
+
+		must.Eq(t, []int{1, 3}, p.PeekN(2))
+		must.Eq(t, []int{1, 3, 5}, p.PeekN(10))
+		must.Nil(t, p.PeekN(0))
+
+		v, ok := p.Next()
+		must.True(t, ok)
+		must.Eq(t, 1, v)
+		must.Eq(t, []int{3, 5}, p.PeekN(3))
+		must.NoError(t, p.Close())
+	})
+
+	t.Run("unread", func(t *testing.T) {
+		p := iter.NewPeekable(iter.FromSlice([]int{1, 3}).Iter())
+
+		v, ok := p.Next()
+		must.True(t, ok)
+		p.Unread(v)
+		p.Unread(0)
+
+		actual, err := iter.ToSlice(iter.ItererFunc[int](func() iter.Iter[int] { return p }))
+		must.NoError(t, err)
+		must.Eq(t, []int{0, 1, 3}, actual)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		p := iter.NewPeekable(iter.FromSlice[int](nil).Iter())
+
+		_, ok := p.Peek()
+		must.False(t, ok)
+		_, ok = p.Next()
+		must.False(t, ok)
+		must.Nil(t, p.PeekN(2))
+		must.NoError(t, p.Close())
+	})
+
+	t.Run("tokenize", func(t *testing.T) {
+		p := iter.NewPeekable(iter.FromSlice([]rune("ab 12 c")).Iter())
+
+		var tokens []string
+		for {
+			p.NextWhile(unicode.IsSpace)
+
+			if word := p.NextWhile(unicode.IsLetter); word != nil {
+				tokens = append(tokens, string(word))
+			} else if number := p.NextWhile(unicode.IsDigit); number != nil {
+				tokens = append(tokens, string(number))
+			} else if _, ok := p.Peek(); !ok {
+				break
+			}
+		}
+
+		must.NoError(t, p.Close())
+		must.Eq(t, []string{"ab", "12", "c"}, tokens)
+	})
+
+	t.Run("next if", func(t *testing.T) {
+		p := iter.NewPeekable(iter.FromSlice([]int{1, 2}).Iter())
+
+		_, ok := p.NextIf(func(i int) bool { return i == 2 })
+		must.False(t, ok)
+
+		v, ok := p.NextIf(func(i int) bool { return i == 1 })
+		must.True(t, ok)
+		must.Eq(t, 1, v)
+		must.NoError(t, p.Close())
+	})
+}
@@ -0,0 +1,143 @@
+package iter
+
+import (
+	"math/rand/v2"
+
+	"golang.org/x/exp/constraints"
+)
+
+func RandomFloats(r *rand.Rand, from float64, to float64) Iterer[float64] {
+	if !(from < to) {
+		panic("from must be less than to")
+	}
+
+	return Generate(func() (float64, bool) {
+		return from + r.Float64()*(to-from), true
+	})
+}
+
+func RandomInts[T constraints.Integer](r *rand.Rand, from T, to T) Iterer[T] {
+	if !(from < to) {
+		panic("from must be less than to")
+	}
+
+	return Generate(func() (T, bool) {
+		return from + T(r.Uint64N(uint64(to)-uint64(from))), true
+	})
+}
+
+func Sample[S any](src Iterer[S], k int, r *rand.Rand) Iterer[S] {
+	if k < 0 {
+		panic("k must not be negative")
+	}
+
+	return ItererFunc[S](func() Iter[S] {
+		return &sampleIter[S]{
+			src: src.Iter(),
+			k:   k,
+			r:   r,
+		}
+	})
+}
+
+type sampleIter[S any] struct {
+	closeGuard
+
+	src Iter[S]
+	k   int
+	r   *rand.Rand
+
+	reservoir []S
+	built     bool
+	pos       int
+}
+
+func (it *sampleIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	if !it.built {
+		it.built = true
+		it.build()
+	}
+
+	if it.pos >= len(it.reservoir) {
+		var def S
+		return def, false
+	}
+
+	it.pos++
+	return it.reservoir[it.pos-1], true
+}
+
+func (it *sampleIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
+
+func (it *sampleIter[S]) build() {
+	seen := 0
+	for elem, ok := it.src.Next(); ok; elem, ok = it.src.Next() {
+		seen++
+		if len(it.reservoir) < it.k {
+			it.reservoir = append(it.reservoir, elem)
+			continue
+		}
+
+		if idx := it.r.IntN(seen); idx < it.k {
+			it.reservoir[idx] = elem
+		}
+	}
+}
+
+func Shuffle[S any](src Iterer[S], r *rand.Rand) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &shuffleIter[S]{
+			src: src.Iter(),
+			r:   r,
+		}
+	})
+}
+
+type shuffleIter[S any] struct {
+	closeGuard
+
+	src Iter[S]
+	r   *rand.Rand
+
+	values []S
+	built  bool
+	pos    int
+}
+
+func (it *shuffleIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	if !it.built {
+		it.built = true
+		for elem, ok := it.src.Next(); ok; elem, ok = it.src.Next() {
+			it.values = append(it.values, elem)
+		}
+	}
+
+	if it.pos >= len(it.values) {
+		var def S
+		return def, false
+	}
+
+	// Each step of the Fisher-Yates shuffle is taken lazily, so stopping early
+	// only pays for the elements that were consumed.
+	idx := it.pos + it.r.IntN(len(it.values)-it.pos)
+	it.values[it.pos], it.values[idx] = it.values[idx], it.values[it.pos]
+
+	it.pos++
+	return it.values[it.pos-1], true
+}
+
+func (it *shuffleIter[S]) Close() error {
+	return it.close(it.src.Close)
+}
@@ -0,0 +1,81 @@
+package iter_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func newRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+func TestRandomFloats(t *testing.T) {
+	t.Parallel()
+
+	first, err := iter.ToSlice(iter.Take(iter.RandomFloats(newRand(), -1, 1), 100))
+	must.NoError(t, err)
+	for _, value := range first {
+		must.True(t, value >= -1 && value < 1)
+	}
+
+	second, err := iter.ToSlice(iter.Take(iter.RandomFloats(newRand(), -1, 1), 100))
+	must.NoError(t, err)
+	must.Eq(t, first, second)
+}
+
+func TestRandomInts(t *testing.T) {
+	t.Parallel()
+
+	first, err := iter.ToSlice(iter.Take(iter.RandomInts[int8](newRand(), -100, 100), 1000))
+	must.NoError(t, err)
+	for _, value := range first {
+		must.True(t, value >= -100 && value < 100)
+	}
+
+	second, err := iter.ToSlice(iter.Take(iter.RandomInts[int8](newRand(), -100, 100), 1000))
+	must.NoError(t, err)
+	must.Eq(t, first, second)
+}
+
+func TestSample(t *testing.T) {
+	t.Parallel()
+
+	src := iter.Range(0, 100, 1)
+
+	first, err := iter.ToSlice(iter.Sample(src, 10, newRand()))
+	must.NoError(t, err)
+	must.Len(t, 10, first)
+
+	second, err := iter.ToSlice(iter.Sample(src, 10, newRand()))
+	must.NoError(t, err)
+	must.Eq(t, first, second)
+
+	small, err := iter.ToSlice(iter.Sample(iter.FromSlice([]int{1, 2, 3}), 10, newRand()))
+	must.NoError(t, err)
+	must.Eq(t, []int{1, 2, 3}, small)
+}
+
+func TestShuffle(t *testing.T) {
+	t.Parallel()
+
+	src := iter.Range(0, 20, 1)
+
+	first, err := iter.ToSlice(iter.Shuffle(src, newRand()))
+	must.NoError(t, err)
+
+	second, err := iter.ToSlice(iter.Shuffle(src, newRand()))
+	must.NoError(t, err)
+	must.Eq(t, first, second)
+
+	sorted := slices.Clone(first)
+	slices.Sort(sorted)
+	expected, err := iter.ToSlice(src)
+	must.NoError(t, err)
+	must.Eq(t, expected, sorted)
+	must.NotEq(t, expected, first)
+}
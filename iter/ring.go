@@ -0,0 +1,48 @@
+package iter
+
+type ring[T any] struct {
+	values []T
+	start  int
+	len    int
+}
+
+func newRing[T any](capacity int) *ring[T] {
+	return &ring[T]{values: make([]T, capacity)}
+}
+
+func (r *ring[T]) Len() int {
+	return r.len
+}
+
+func (r *ring[T]) Pop() (T, bool) {
+	if r.len == 0 {
+		var def T
+		return def, false
+	}
+
+	value := r.values[r.start]
+	var def T
+	r.values[r.start] = def
+	r.start = (r.start + 1) % len(r.values)
+	r.len--
+	return value, true
+}
+
+func (r *ring[T]) Push(v T) (T, bool) {
+	if len(r.values) == 0 {
+		return v, true
+	}
+
+	if r.len == len(r.values) {
+		evicted := r.values[r.start]
+		r.values[r.start] = v
+		r.start = (r.start + 1) % len(r.values)
+		return evicted, true
+	}
+
+	r.values[(r.start+r.len)%len(r.values)] = v
+	r.len++
+
+	var def T
+	return def, false
+}
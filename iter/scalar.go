@@ -1,13 +1,16 @@
 package iter
 
 import (
+	"math"
+	"slices"
+
 	"golang.org/x/exp/constraints"
 )
 
 func All[S any](src Iterer[S], predicate func(S) bool) (result bool, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	result = true
@@ -24,7 +27,7 @@ func All[S any](src Iterer[S], predicate func(S) bool) (result bool, err error)
 func Any[S any](src Iterer[S], predicate func(S) bool) (result bool, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
@@ -37,10 +40,39 @@ func Any[S any](src Iterer[S], predicate func(S) bool) (result bool, err error)
 	return
 }
 
+func ArgMax[S constraints.Ordered](src Iterer[S]) (int, error) {
+	_, idx, err := bestBy(src, identity[S], func(a, b S) bool { return a > b })
+	return idx, err
+}
+
+func ArgMin[S constraints.Ordered](src Iterer[S]) (int, error) {
+	_, idx, err := bestBy(src, identity[S], func(a, b S) bool { return a < b })
+	return idx, err
+}
+
+func Average[S constraints.Integer | constraints.Float](src Iterer[S]) (result float64, err error) {
+	it := src.Iter()
+	defer func() {
+		err = combineErrors(err, it.Close())
+	}()
+
+	count := 0
+	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
+		count++
+		result += (float64(elem) - result) / float64(count)
+	}
+
+	if count == 0 {
+		err = ErrEmptyIter
+	}
+
+	return
+}
+
 func Collect[S any](src Iterer[S], dst interface{ Add(S) }) (err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
@@ -53,7 +85,7 @@ func Collect[S any](src Iterer[S], dst interface{ Add(S) }) (err error) {
 func Contains[S comparable](src Iterer[S], target S) (result bool, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
@@ -69,10 +101,7 @@ func Contains[S comparable](src Iterer[S], target S) (result bool, err error) {
 func ElementAt[S any](src Iterer[S], idx uint) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		cerr := it.Close()
-		if cerr != nil {
-			err = cerr
-		}
+		err = combineErrors(err, it.Close())
 	}()
 
 	pos := uint(0)
@@ -96,10 +125,7 @@ func ElementAt[S any](src Iterer[S], idx uint) (result S, err error) {
 func First[S any](src Iterer[S]) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		cerr := it.Close()
-		if cerr != nil {
-			err = cerr
-		}
+		err = combineErrors(err, it.Close())
 	}()
 
 	elem, ok := it.Next()
@@ -115,7 +141,7 @@ func First[S any](src Iterer[S]) (result S, err error) {
 func FirstOrDefault[S any](src Iterer[S]) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	elem, ok := it.Next()
@@ -129,7 +155,7 @@ func FirstOrDefault[S any](src Iterer[S]) (result S, err error) {
 func Fold[S, R any](src Iterer[S], seed R, reducer func(R, S) R) (result R, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	result = seed
@@ -140,13 +166,35 @@ func Fold[S, R any](src Iterer[S], seed R, reducer func(R, S) R) (result R, err
 	return
 }
 
-func Last[S any](src Iterer[S]) (result S, err error) {
+func Histogram[S constraints.Integer | constraints.Float](src Iterer[S], boundaries []S) (result []int, err error) {
+	if !slices.IsSorted(boundaries) {
+		return nil, ErrUnsorted
+	}
+
 	it := src.Iter()
 	defer func() {
-		cerr := it.Close()
-		if cerr != nil {
-			err = cerr
+		err = combineErrors(err, it.Close())
+	}()
+
+	result = make([]int, len(boundaries)+1)
+	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
+		idx, found := slices.BinarySearch(boundaries, elem)
+		if found {
+			for idx < len(boundaries) && boundaries[idx] == elem {
+				idx++
+			}
 		}
+
+		result[idx]++
+	}
+
+	return
+}
+
+func Last[S any](src Iterer[S]) (result S, err error) {
+	it := src.Iter()
+	defer func() {
+		err = combineErrors(err, it.Close())
 	}()
 
 	isEmpty := true
@@ -165,7 +213,7 @@ func Last[S any](src Iterer[S]) (result S, err error) {
 func LastOrDefault[S any](src Iterer[S]) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
@@ -181,7 +229,7 @@ func Len[S any](src Iterer[S]) (result int, err error) {
 	} else {
 		it := src.Iter()
 		defer func() {
-			err = it.Close()
+			err = combineErrors(err, it.Close())
 		}()
 
 		for _, ok = it.Next(); ok; _, ok = it.Next() {
@@ -192,13 +240,19 @@ func Len[S any](src Iterer[S]) (result int, err error) {
 	return
 }
 
+func LinearBuckets[S constraints.Integer | constraints.Float](start S, width S, count int) []S {
+	boundaries := make([]S, count)
+	for i := range boundaries {
+		boundaries[i] = start + S(i)*width
+	}
+
+	return boundaries
+}
+
 func Max[S constraints.Ordered](src Iterer[S]) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		cerr := it.Close()
-		if cerr != nil {
-			err = cerr
-		}
+		err = combineErrors(err, it.Close())
 	}()
 
 	elem, ok := it.Next()
@@ -216,13 +270,24 @@ func Max[S constraints.Ordered](src Iterer[S]) (result S, err error) {
 	return
 }
 
+func MaxBy[S any, K constraints.Ordered](src Iterer[S], keySelector func(S) K) (S, error) {
+	result, _, err := bestBy(src, keySelector, func(a, b K) bool { return a > b })
+	return result, err
+}
+
+func MaxFunc[S any](src Iterer[S], comparer func(S, S) int) (S, error) {
+	result, _, err := bestBy(src, identity[S], func(a, b S) bool { return comparer(a, b) > 0 })
+	return result, err
+}
+
+func Median[S constraints.Integer | constraints.Float](src Iterer[S]) (float64, error) {
+	return Percentile(src, 50)
+}
+
 func Min[S constraints.Ordered](src Iterer[S]) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		cerr := it.Close()
-		if cerr != nil {
-			err = cerr
-		}
+		err = combineErrors(err, it.Close())
 	}()
 
 	elem, ok := it.Next()
@@ -240,13 +305,92 @@ func Min[S constraints.Ordered](src Iterer[S]) (result S, err error) {
 	return
 }
 
-func Reduce[S any](src Iterer[S], reducer func(S, S) S) (result S, err error) {
+func MinBy[S any, K constraints.Ordered](src Iterer[S], keySelector func(S) K) (S, error) {
+	result, _, err := bestBy(src, keySelector, func(a, b K) bool { return a < b })
+	return result, err
+}
+
+func MinFunc[S any](src Iterer[S], comparer func(S, S) int) (S, error) {
+	result, _, err := bestBy(src, identity[S], func(a, b S) bool { return comparer(a, b) < 0 })
+	return result, err
+}
+
+func MinMax[S constraints.Ordered](src Iterer[S]) (lo S, hi S, err error) {
 	it := src.Iter()
 	defer func() {
-		cerr := it.Close()
-		if cerr != nil {
-			err = cerr
+		err = combineErrors(err, it.Close())
+	}()
+
+	elem, ok := it.Next()
+	if !ok {
+		err = ErrEmptyIter
+	} else {
+		lo, hi = elem, elem
+		for elem, ok = it.Next(); ok; elem, ok = it.Next() {
+			if elem < lo {
+				lo = elem
+			}
+			if elem > hi {
+				hi = elem
+			}
 		}
+	}
+
+	return
+}
+
+func Mode[S comparable](src Iterer[S]) (result S, err error) {
+	it := src.Iter()
+	defer func() {
+		err = combineErrors(err, it.Close())
+	}()
+
+	counts := make(map[S]int)
+	best := 0
+	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
+		counts[elem]++
+		if counts[elem] > best {
+			best = counts[elem]
+			result = elem
+		}
+	}
+
+	if best == 0 {
+		err = ErrEmptyIter
+	}
+
+	return
+}
+
+func Percentile[S constraints.Integer | constraints.Float](src Iterer[S], p float64) (float64, error) {
+	if !(p >= 0 && p <= 100) {
+		return 0, ErrOutOfRange
+	}
+
+	values, err := ToSlice(src)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(values) == 0 {
+		return 0, ErrEmptyIter
+	}
+
+	values = slices.Clone(values)
+	slices.Sort(values)
+
+	rank := p / 100 * float64(len(values)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	frac := rank - float64(lower)
+
+	return float64(values[lower]) + frac*(float64(values[upper])-float64(values[lower])), nil
+}
+
+func Reduce[S any](src Iterer[S], reducer func(S, S) S) (result S, err error) {
+	it := src.Iter()
+	defer func() {
+		err = combineErrors(err, it.Close())
 	}()
 
 	elem, ok := it.Next()
@@ -262,10 +406,19 @@ func Reduce[S any](src Iterer[S], reducer func(S, S) S) (result S, err error) {
 	return
 }
 
+func StdDev[S constraints.Integer | constraints.Float](src Iterer[S]) (float64, error) {
+	variance, err := Variance(src)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Sqrt(variance), nil
+}
+
 func Sum[S constraints.Integer | constraints.Float](src Iterer[S]) (result S, err error) {
 	it := src.Iter()
 	defer func() {
-		err = it.Close()
+		err = combineErrors(err, it.Close())
 	}()
 
 	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
@@ -281,7 +434,7 @@ func ToSlice[S any](src Iterer[S]) (result []S, err error) {
 	} else {
 		it := src.Iter()
 		defer func() {
-			err = it.Close()
+			err = combineErrors(err, it.Close())
 		}()
 
 		for elem, ok := it.Next(); ok; elem, ok = it.Next() {
@@ -291,3 +444,55 @@ func ToSlice[S any](src Iterer[S]) (result []S, err error) {
 
 	return
 }
+
+func Variance[S constraints.Integer | constraints.Float](src Iterer[S]) (result float64, err error) {
+	it := src.Iter()
+	defer func() {
+		err = combineErrors(err, it.Close())
+	}()
+
+	count := 0
+	mean := 0.0
+	m2 := 0.0
+	for elem, ok := it.Next(); ok; elem, ok = it.Next() {
+		count++
+		value := float64(elem)
+		delta := value - mean
+		mean += delta / float64(count)
+		m2 += delta * (value - mean)
+	}
+
+	if count == 0 {
+		err = ErrEmptyIter
+	} else {
+		result = m2 / float64(count)
+	}
+
+	return
+}
+
+func bestBy[S, K any](src Iterer[S], keySelector func(S) K, better func(K, K) bool) (result S, idx int, err error) {
+	it := src.Iter()
+	defer func() {
+		err = combineErrors(err, it.Close())
+	}()
+
+	elem, ok := it.Next()
+	if !ok {
+		idx = -1
+		err = ErrEmptyIter
+		return
+	}
+
+	result = elem
+	bestKey := keySelector(elem)
+	pos := 0
+	for elem, ok = it.Next(); ok; elem, ok = it.Next() {
+		pos++
+		if key := keySelector(elem); better(key, bestKey) {
+			result, bestKey, idx = elem, key, pos
+		}
+	}
+
+	return
+}
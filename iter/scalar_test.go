@@ -103,6 +103,134 @@ func Test_Any(t *testing.T) {
 	}
 }
 
+func Test_ArgMax(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected int
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []int{3, 9, 1, 9},
+			expected: 1,
+		},
+		{
+			name:     "first",
+			input:    []int{9, 3},
+			expected: 0,
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: -1,
+			err:      iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ArgMax(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+			}
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_ArgMin(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected int
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []int{3, 1, 9, 1},
+			expected: 1,
+		},
+		{
+			name:     "last",
+			input:    []int{3, 2},
+			expected: 1,
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: -1,
+			err:      iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ArgMin(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+			}
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
+func Test_Average(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected float64
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []int{1, 2, 3, 4},
+			expected: 2.5,
+		},
+		{
+			name:     "single",
+			input:    []int{7},
+			expected: 7,
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.Average(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.InDelta(t, tc.expected, actual, 1e-9)
+			}
+		})
+	}
+}
+
 func Test_Contains(t *testing.T) {
 	t.Parallel()
 
@@ -324,6 +452,60 @@ func Test_Fold(t *testing.T) {
 	}
 }
 
+func Test_Histogram(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		input      []int
+		boundaries []int
+		expected   []int
+		err        error
+	}{
+		{
+			name:       "custom",
+			input:      []int{-5, 0, 1, 9, 10, 11, 100},
+			boundaries: []int{0, 10, 50},
+			expected:   []int{1, 3, 2, 1},
+		},
+		{
+			name:       "linear",
+			input:      []int{0, 5, 10, 15, 20, 25},
+			boundaries: iter.LinearBuckets(0, 10, 3),
+			expected:   []int{0, 2, 2, 2},
+		},
+		{
+			name:       "empty",
+			input:      nil,
+			boundaries: []int{0, 10},
+			expected:   []int{0, 0, 0},
+		},
+		{
+			name:       "unsorted boundaries",
+			input:      []int{1, 2, 3},
+			boundaries: []int{10, 0},
+			expected:   nil,
+			err:        iter.ErrUnsorted,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.Histogram(it, tc.boundaries)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+			}
+
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
+
 func Test_Last(t *testing.T) {
 	t.Parallel()
 
@@ -496,6 +678,98 @@ func Test_Max(t *testing.T) {
 	}
 }
 
+func Test_MaxBy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []string
+		expected string
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []string{"bb", "a", "ccc", "ddd"},
+			expected: "ccc",
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.MaxBy(it, func(s string) int { return len(s) })
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.Eq(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_MaxFunc(t *testing.T) {
+	t.Parallel()
+
+	it := iter.FromSlice([]string{"bb", "a", "ccc", "ddd"})
+
+	actual, err := iter.MaxFunc(it, func(a, b string) int { return len(a) - len(b) })
+	must.NoError(t, err)
+	must.Eq(t, "ccc", actual)
+
+	_, err = iter.MaxFunc(iter.Empty[string](), func(a, b string) int { return len(a) - len(b) })
+	must.ErrorIs(t, err, iter.ErrEmptyIter)
+}
+
+func Test_Median(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected float64
+		err      error
+	}{
+		{
+			name:     "odd",
+			input:    []int{5, 1, 3},
+			expected: 3,
+		},
+		{
+			name:     "even",
+			input:    []int{4, 1, 3, 2},
+			expected: 2.5,
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.Median(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.InDelta(t, tc.expected, actual, 1e-9)
+			}
+		})
+	}
+}
+
 func Test_Min(t *testing.T) {
 	t.Parallel()
 
@@ -549,6 +823,202 @@ func Test_Min(t *testing.T) {
 	}
 }
 
+func Test_MinBy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []string
+		expected string
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []string{"bb", "a", "ccc", "d"},
+			expected: "a",
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.MinBy(it, func(s string) int { return len(s) })
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.Eq(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_MinFunc(t *testing.T) {
+	t.Parallel()
+
+	it := iter.FromSlice([]string{"bb", "a", "ccc", "d"})
+
+	actual, err := iter.MinFunc(it, func(a, b string) int { return len(a) - len(b) })
+	must.NoError(t, err)
+	must.Eq(t, "a", actual)
+
+	_, err = iter.MinFunc(iter.Empty[string](), func(a, b string) int { return len(a) - len(b) })
+	must.ErrorIs(t, err, iter.ErrEmptyIter)
+}
+
+func Test_MinMax(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       []int
+		expectedMin int
+		expectedMax int
+		err         error
+	}{
+		{
+			name:        "many",
+			input:       []int{3, 9, 1, 5},
+			expectedMin: 1,
+			expectedMax: 9,
+		},
+		{
+			name:        "single",
+			input:       []int{4},
+			expectedMin: 4,
+			expectedMax: 4,
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actualMin, actualMax, err := iter.MinMax(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.Eq(t, tc.expectedMin, actualMin)
+				must.Eq(t, tc.expectedMax, actualMax)
+			}
+		})
+	}
+}
+
+func Test_Mode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected int
+		err      error
+	}{
+		{
+			name:     "single mode",
+			input:    []int{1, 3, 3, 5, 3, 1},
+			expected: 3,
+		},
+		{
+			name:     "tie picks first to reach count",
+			input:    []int{1, 5, 5, 1},
+			expected: 5,
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.Mode(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.Eq(t, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func Test_Percentile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		input      []int
+		percentile float64
+		expected   float64
+		err        error
+	}{
+		{
+			name:       "min",
+			input:      []int{5, 1, 4, 2, 3},
+			percentile: 0,
+			expected:   1,
+		},
+		{
+			name:       "max",
+			input:      []int{5, 1, 4, 2, 3},
+			percentile: 100,
+			expected:   5,
+		},
+		{
+			name:       "interpolated",
+			input:      []int{10, 20, 30, 40},
+			percentile: 90,
+			expected:   37,
+		},
+		{
+			name:       "out of range",
+			input:      []int{1},
+			percentile: 101,
+			err:        iter.ErrOutOfRange,
+		},
+		{
+			name:       "empty",
+			input:      nil,
+			percentile: 50,
+			err:        iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.Percentile(it, tc.percentile)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.InDelta(t, tc.expected, actual, 1e-9)
+			}
+		})
+	}
+}
+
 func Test_Reduce(t *testing.T) {
 	t.Parallel()
 
@@ -602,6 +1072,48 @@ func Test_Reduce(t *testing.T) {
 	}
 }
 
+func Test_StdDev(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected float64
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []int{2, 4, 4, 4, 5, 5, 7, 9},
+			expected: 2,
+		},
+		{
+			name:     "constant",
+			input:    []int{3, 3, 3},
+			expected: 0,
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.StdDev(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.InDelta(t, tc.expected, actual, 1e-9)
+			}
+		})
+	}
+}
+
 func Test_Sum(t *testing.T) {
 	t.Parallel()
 
@@ -639,3 +1151,45 @@ func Test_Sum(t *testing.T) {
 		})
 	}
 }
+
+func Test_Variance(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []int
+		expected float64
+		err      error
+	}{
+		{
+			name:     "many",
+			input:    []int{2, 4, 4, 4, 5, 5, 7, 9},
+			expected: 4,
+		},
+		{
+			name:     "large offset",
+			input:    []int{1000000001, 1000000002, 1000000003},
+			expected: 2.0 / 3.0,
+		},
+		{
+			name:  "empty",
+			input: nil,
+			err:   iter.ErrEmptyIter,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.Variance(it)
+			if tc.err != nil {
+				must.ErrorIs(t, err, tc.err)
+			} else {
+				must.NoError(t, err)
+				must.InDelta(t, tc.expected, actual, 1e-9)
+			}
+		})
+	}
+}
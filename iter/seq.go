@@ -0,0 +1,112 @@
+package iter
+
+import (
+	stditer "iter"
+)
+
+type Seq[V any] = stditer.Seq[V]
+
+type Seq2[K, V any] = stditer.Seq2[K, V]
+
+func FromSeq[T any](seq Seq[T]) Iterer[T] {
+	return ItererFunc[T](func() Iter[T] {
+		next, stop := stditer.Pull(seq)
+		return &seqIter[T]{
+			next: next,
+			stop: stop,
+		}
+	})
+}
+
+type seqIter[T any] struct {
+	closeGuard
+
+	next func() (T, bool)
+	stop func()
+}
+
+func (it *seqIter[T]) Next() (T, bool) {
+	if it.closed {
+		var def T
+		return def, false
+	}
+
+	return it.next()
+}
+
+func (it *seqIter[T]) Close() error {
+	return it.close(func() error {
+		it.stop()
+		return nil
+	})
+}
+
+func FromSeq2[K comparable, V any](seq Seq2[K, V]) Iterer[KeyValuePair[K, V]] {
+	return ItererFunc[KeyValuePair[K, V]](func() Iter[KeyValuePair[K, V]] {
+		next, stop := stditer.Pull2(seq)
+		return &seq2Iter[K, V]{
+			next: next,
+			stop: stop,
+		}
+	})
+}
+
+type seq2Iter[K comparable, V any] struct {
+	closeGuard
+
+	next func() (K, V, bool)
+	stop func()
+}
+
+func (it *seq2Iter[K, V]) Next() (KeyValuePair[K, V], bool) {
+	if it.closed {
+		var def KeyValuePair[K, V]
+		return def, false
+	}
+
+	k, v, ok := it.next()
+	return KeyValuePair[K, V]{Key: k, Value: v}, ok
+}
+
+func (it *seq2Iter[K, V]) Close() error {
+	return it.close(func() error {
+		it.stop()
+		return nil
+	})
+}
+
+func ToSeq[T any](src Iterer[T], errp *error) Seq[T] {
+	return func(yield func(T) bool) {
+		it := src.Iter()
+		defer func() {
+			err := it.Close()
+			if errp != nil {
+				*errp = err
+			}
+		}()
+
+		for elem, ok := it.Next(); ok; elem, ok = it.Next() {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+func ToSeq2[K comparable, V any](src Iterer[KeyValuePair[K, V]], errp *error) Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		it := src.Iter()
+		defer func() {
+			err := it.Close()
+			if errp != nil {
+				*errp = err
+			}
+		}()
+
+		for elem, ok := it.Next(); ok; elem, ok = it.Next() {
+			if !yield(elem.Key, elem.Value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package iter_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestFromSeq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.FromSeq(slices.Values([]int{1, 3, 5})))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+	})
+
+	t.Run("early close", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.Take(iter.FromSeq(slices.Values([]int{1, 3, 5})), 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3}, actual)
+	})
+}
+
+func TestFromSeq2(t *testing.T) {
+	t.Parallel()
+
+	actual, err := iter.ToSlice(iter.FromSeq2(slices.All([]string{"a", "b"})))
+	must.NoError(t, err)
+	must.Eq(t, []iter.KeyValuePair[int, string]{{Key: 0, Value: "a"}, {Key: 1, Value: "b"}}, actual)
+}
+
+func TestToSeq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all", func(t *testing.T) {
+		var err error
+		var actual []int
+		for v := range iter.ToSeq(iter.FromSlice([]int{1, 3, 5}), &err) {
+			actual = append(actual, v)
+		}
+
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+	})
+
+	t.Run("break", func(t *testing.T) {
+		var actual []int
+		for v := range iter.ToSeq(iter.FromSlice([]int{1, 3, 5}), nil) {
+			actual = append(actual, v)
+			if v == 3 {
+				break
+			}
+		}
+
+		must.Eq(t, []int{1, 3}, actual)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := iter.Concat(iter.FromSlice([]int{1, 3}), iter.Err[int](expectedErr))
+
+		var err error
+		actual := slices.Collect(iter.ToSeq(src, &err))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, []int{1, 3}, actual)
+	})
+}
+
+func TestToSeq2(t *testing.T) {
+	t.Parallel()
+
+	src := iter.FromSlice([]iter.KeyValuePair[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}})
+
+	var err error
+	var keys []string
+	var values []int
+	for k, v := range iter.ToSeq2(src, &err) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	must.NoError(t, err)
+	must.Eq(t, []string{"a", "b"}, keys)
+	must.Eq(t, []int{1, 2}, values)
+}
@@ -0,0 +1,151 @@
+package iter
+
+func Except[S comparable](first Iterer[S], second Iterer[S]) Iterer[S] {
+	return ExceptBy(first, second, identity[S])
+}
+
+func ExceptBy[S any, K comparable](first Iterer[S], second Iterer[S], keySelector func(S) K) Iterer[S] {
+	return setOp(first, second, keySelector, setOpExcept)
+}
+
+func Intersect[S comparable](first Iterer[S], second Iterer[S]) Iterer[S] {
+	return IntersectBy(first, second, identity[S])
+}
+
+func IntersectBy[S any, K comparable](first Iterer[S], second Iterer[S], keySelector func(S) K) Iterer[S] {
+	return setOp(first, second, keySelector, setOpIntersect)
+}
+
+func SymmetricDifference[S comparable](first Iterer[S], second Iterer[S]) Iterer[S] {
+	return SymmetricDifferenceBy(first, second, identity[S])
+}
+
+func SymmetricDifferenceBy[S any, K comparable](first Iterer[S], second Iterer[S], keySelector func(S) K) Iterer[S] {
+	return setOp(first, second, keySelector, setOpSymmetricDifference)
+}
+
+func Union[S comparable](first Iterer[S], second Iterer[S]) Iterer[S] {
+	return Distinct(Concat(first, second))
+}
+
+func UnionBy[S any, K comparable](first Iterer[S], second Iterer[S], keySelector func(S) K) Iterer[S] {
+	return DistinctBy(Concat(first, second), keySelector)
+}
+
+func identity[S any](v S) S {
+	return v
+}
+
+type setOpKind int
+
+const (
+	setOpExcept setOpKind = iota
+	setOpIntersect
+	setOpSymmetricDifference
+)
+
+func setOp[S any, K comparable](first Iterer[S], second Iterer[S], keySelector func(S) K, kind setOpKind) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		return &setOpIter[S, K]{
+			first:       first.Iter(),
+			second:      second,
+			keySelector: keySelector,
+			kind:        kind,
+			seen:        make(map[K]struct{}),
+		}
+	})
+}
+
+type setOpIter[S any, K comparable] struct {
+	closeGuard
+
+	first       Iter[S]
+	second      Iterer[S]
+	keySelector func(S) K
+	kind        setOpKind
+
+	others    map[K]struct{}
+	rest      []S
+	seen      map[K]struct{}
+	built     bool
+	firstDone bool
+	pos       int
+	err       error
+}
+
+func (it *setOpIter[S, K]) Next() (S, bool) {
+	if it.closed || it.err != nil {
+		var def S
+		return def, false
+	}
+
+	if !it.built {
+		it.built = true
+		if it.err = it.build(); it.err != nil {
+			var def S
+			return def, false
+		}
+	}
+
+	if !it.firstDone {
+		for {
+			elem, ok := it.first.Next()
+			if !ok {
+				it.firstDone = true
+				break
+			}
+
+			key := it.keySelector(elem)
+			if _, ok := it.seen[key]; ok {
+				continue
+			}
+
+			it.seen[key] = struct{}{}
+			_, inOthers := it.others[key]
+			if inOthers == (it.kind == setOpIntersect) {
+				return elem, true
+			}
+		}
+	}
+
+	if it.kind == setOpSymmetricDifference {
+		for it.pos < len(it.rest) {
+			elem := it.rest[it.pos]
+			it.pos++
+			if _, ok := it.seen[it.keySelector(elem)]; !ok {
+				return elem, true
+			}
+		}
+	}
+
+	var def S
+	return def, false
+}
+
+func (it *setOpIter[S, K]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.err, it.first.Close())
+	})
+}
+
+func (it *setOpIter[S, K]) build() (err error) {
+	second := it.second.Iter()
+	defer func() {
+		err = second.Close()
+	}()
+
+	it.others = make(map[K]struct{})
+	for elem, ok := second.Next(); ok; elem, ok = second.Next() {
+		key := it.keySelector(elem)
+		if _, ok := it.others[key]; ok {
+			continue
+		}
+
+		it.others[key] = struct{}{}
+		if it.kind == setOpSymmetricDifference {
+			it.rest = append(it.rest, elem)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,131 @@
+package iter_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestSetOperations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		op       func(iter.Iterer[int], iter.Iterer[int]) iter.Iterer[int]
+		first    []int
+		second   []int
+		expected []int
+	}{
+		{
+			name:     "union",
+			op:       iter.Union[int],
+			first:    []int{5, 1, 3, 1},
+			second:   []int{4, 3, 2, 4},
+			expected: []int{5, 1, 3, 4, 2},
+		},
+		{
+			name:     "union empty",
+			op:       iter.Union[int],
+			expected: nil,
+		},
+		{
+			name:     "intersect",
+			op:       iter.Intersect[int],
+			first:    []int{5, 1, 3, 1, 4},
+			second:   []int{4, 3, 2, 4},
+			expected: []int{3, 4},
+		},
+		{
+			name:     "intersect empty second",
+			op:       iter.Intersect[int],
+			first:    []int{5, 1, 3},
+			expected: nil,
+		},
+		{
+			name:     "except",
+			op:       iter.Except[int],
+			first:    []int{5, 1, 3, 1, 4},
+			second:   []int{4, 3, 2, 4},
+			expected: []int{5, 1},
+		},
+		{
+			name:     "except empty second",
+			op:       iter.Except[int],
+			first:    []int{5, 1, 5},
+			expected: []int{5, 1},
+		},
+		{
+			name:     "symmetric difference",
+			op:       iter.SymmetricDifference[int],
+			first:    []int{5, 1, 3, 1, 4},
+			second:   []int{4, 6, 3, 2, 6},
+			expected: []int{5, 1, 6, 2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(tc.op(iter.FromSlice(tc.first), iter.FromSlice(tc.second)))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+
+	t.Run("second error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		second := iter.Concat(iter.FromSlice([]int{1}), iter.Err[int](expectedErr))
+
+		actual, err := iter.ToSlice(iter.Except(iter.FromSlice([]int{1, 2}), second))
+		must.ErrorIs(t, err, expectedErr)
+		must.Nil(t, actual)
+	})
+}
+
+func TestSetOperationsBy(t *testing.T) {
+	t.Parallel()
+
+	first := iter.FromSlice([]string{"apple", "Avocado", "banana", "cherry"})
+	second := iter.FromSlice([]string{"Banana", "date", "Cranberry"})
+	key := func(s string) byte { return strings.ToLower(s)[0] }
+
+	testCases := []struct {
+		name     string
+		input    iter.Iterer[string]
+		expected []string
+	}{
+		{
+			name:     "union",
+			input:    iter.UnionBy(first, second, key),
+			expected: []string{"apple", "banana", "cherry", "date"},
+		},
+		{
+			name:     "intersect",
+			input:    iter.IntersectBy(first, second, key),
+			expected: []string{"banana", "cherry"},
+		},
+		{
+			name:     "except",
+			input:    iter.ExceptBy(first, second, key),
+			expected: []string{"apple"},
+		},
+		{
+			name:     "symmetric difference",
+			input:    iter.SymmetricDifferenceBy(first, second, key),
+			expected: []string{"apple", "date"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(tc.input)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package iter
+
+import "sync"
+
+// Memoize caches the elements of src so that every Iter replays them without
+// reading src again. The source is opened once and closed once: when it is
+// exhausted, or when every open reader has closed. A reader that later needs
+// more than the cache holds stops and reports ErrClosed from Close rather than
+// reopening src.
+func Memoize[S any](src Iterer[S]) Iterer[S] {
+	m := &memoizer[S]{
+		src: src,
+	}
+
+	return ItererFunc[S](func() Iter[S] {
+		m.acquire()
+		return &memoizeIter[S]{
+			memo: m,
+		}
+	})
+}
+
+type memoizer[S any] struct {
+	src Iterer[S]
+
+	mu        sync.Mutex
+	it        Iter[S]
+	cache     []S
+	active    int
+	srcClosed bool
+	exhausted bool
+	err       error
+}
+
+func (m *memoizer[S]) acquire() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active++
+}
+
+func (m *memoizer[S]) elementAt(pos int) (S, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if pos < len(m.cache) {
+		return m.cache[pos], true, nil
+	}
+
+	var def S
+	if m.exhausted {
+		return def, false, nil
+	}
+
+	if m.srcClosed {
+		return def, false, ErrClosed
+	}
+
+	if m.it == nil {
+		m.it = m.src.Iter()
+	}
+
+	value, ok := m.it.Next()
+	if !ok {
+		m.exhausted = true
+		m.closeSrc()
+		return value, false, nil
+	}
+
+	m.cache = append(m.cache, value)
+	return value, true, nil
+}
+
+func (m *memoizer[S]) closeSrc() {
+	if !m.srcClosed && m.it != nil {
+		m.srcClosed = true
+		m.err = m.it.Close()
+		m.it = nil
+	}
+}
+
+func (m *memoizer[S]) release() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active--
+	if m.active == 0 {
+		m.closeSrc()
+	}
+
+	return m.err
+}
+
+type memoizeIter[S any] struct {
+	closeGuard
+
+	memo *memoizer[S]
+	pos  int
+	err  error
+}
+
+func (it *memoizeIter[S]) Next() (S, bool) {
+	if it.closed || it.err != nil {
+		var def S
+		return def, false
+	}
+
+	value, ok, err := it.memo.elementAt(it.pos)
+	if ok {
+		it.pos++
+	}
+
+	it.err = err
+	return value, ok
+}
+
+func (it *memoizeIter[S]) Close() error {
+	return it.close(func() error {
+		return combineErrors(it.err, it.memo.release())
+	})
+}
+
+// Tee returns n readers that share one pass over src. Elements are buffered
+// until every reader has read them, including readers that have not called Iter
+// yet, so a reader that is never used keeps the whole pass buffered. The source
+// is closed when it is exhausted or once all n readers have closed.
+func Tee[S any](src Iterer[S], n int) []Iterer[S] {
+	t := &teer[S]{
+		src: src,
+		n:   n,
+	}
+
+	iterers := make([]Iterer[S], n)
+	for i := range iterers {
+		iterers[i] = t.reader(i)
+	}
+
+	return iterers
+}
+
+type teer[S any] struct {
+	src Iterer[S]
+	n   int
+
+	mu   sync.Mutex
+	pass *teePass[S]
+}
+
+func (t *teer[S]) reader(idx int) Iterer[S] {
+	return ItererFunc[S](func() Iter[S] {
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		if t.pass == nil || !t.pass.join(idx) {
+			t.pass = &teePass[S]{
+				src:       t.src.Iter(),
+				joined:    make([]bool, t.n),
+				closed:    make([]bool, t.n),
+				positions: make([]int, t.n),
+			}
+			t.pass.join(idx)
+		}
+
+		return &teeIter[S]{
+			pass: t.pass,
+			idx:  idx,
+		}
+	})
+}
+
+type teePass[S any] struct {
+	src Iter[S]
+
+	mu        sync.Mutex
+	joined    []bool
+	closed    []bool
+	positions []int
+	buffer    []S
+	offset    int
+	srcClosed bool
+	err       error
+}
+
+func (p *teePass[S]) join(idx int) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.joined[idx] {
+		return false
+	}
+
+	p.joined[idx] = true
+	return true
+}
+
+func (p *teePass[S]) next(idx int) (S, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pos := p.positions[idx]
+	if pos < p.offset+len(p.buffer) {
+		value := p.buffer[pos-p.offset]
+		p.positions[idx]++
+		p.trim()
+		return value, true
+	}
+
+	if p.srcClosed {
+		var def S
+		return def, false
+	}
+
+	value, ok := p.src.Next()
+	if !ok {
+		p.closeSrc()
+		return value, false
+	}
+
+	p.buffer = append(p.buffer, value)
+	p.positions[idx]++
+	p.trim()
+	return value, true
+}
+
+func (p *teePass[S]) closeReader(idx int) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed[idx] = true
+	p.trim()
+
+	for _, closed := range p.closed {
+		if !closed {
+			return p.err
+		}
+	}
+
+	p.closeSrc()
+	return p.err
+}
+
+func (p *teePass[S]) closeSrc() {
+	if !p.srcClosed {
+		p.srcClosed = true
+		p.err = p.src.Close()
+	}
+}
+
+func (p *teePass[S]) trim() {
+	lowest := p.offset + len(p.buffer)
+	for i, pos := range p.positions {
+		if !p.closed[i] && pos < lowest {
+			lowest = pos
+		}
+	}
+
+	drop := lowest - p.offset
+	if drop <= 0 {
+		return
+	}
+
+	var def S
+	for i := 0; i < drop; i++ {
+		p.buffer[i] = def
+	}
+
+	p.buffer = p.buffer[drop:]
+	p.offset = lowest
+}
+
+type teeIter[S any] struct {
+	closeGuard
+
+	pass *teePass[S]
+	idx  int
+}
+
+func (it *teeIter[S]) Next() (S, bool) {
+	if it.closed {
+		var def S
+		return def, false
+	}
+
+	return it.pass.next(it.idx)
+}
+
+func (it *teeIter[S]) Close() error {
+	return it.close(func() error {
+		return it.pass.closeReader(it.idx)
+	})
+}
@@ -0,0 +1,247 @@
+package iter_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+type countingSource struct {
+	values []int
+	iters  int
+	reads  int
+	closes int
+	err    error
+}
+
+func (s *countingSource) Iter() iter.Iter[int] {
+	s.iters++
+	return &countingSourceIter{src: s}
+}
+
+type countingSourceIter struct {
+	src *countingSource
+	pos int
+}
+
+func (it *countingSourceIter) Next() (int, bool) {
+	if it.pos >= len(it.src.values) {
+		return 0, false
+	}
+
+	it.pos++
+	it.src.reads++
+	return it.src.values[it.pos-1], true
+}
+
+func (it *countingSourceIter) Close() error {
+	it.src.closes++
+	return it.src.err
+}
+
+func TestMemoize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replays from cache", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		memo := iter.Memoize[int](src)
+
+		for i := 0; i < 3; i++ {
+			actual, err := iter.ToSlice(memo)
+			must.NoError(t, err)
+			must.Eq(t, []int{1, 3, 5}, actual)
+		}
+
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("partial enumeration continues while a reader is open", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		memo := iter.Memoize[int](src)
+		open := memo.Iter()
+
+		actual, err := iter.ToSlice(iter.Take(memo, 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3}, actual)
+		must.Eq(t, 0, src.closes)
+
+		actual, err = iter.ToSlice(memo)
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+		must.NoError(t, open.Close())
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("partial enumeration after close fails", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		memo := iter.Memoize[int](src)
+
+		actual, err := iter.ToSlice(iter.Take(memo, 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3}, actual)
+		must.Eq(t, 1, src.closes)
+
+		actual, err = iter.ToSlice(memo)
+		must.ErrorIs(t, err, iter.ErrClosed)
+		must.Eq(t, []int{1, 3}, actual)
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("cursor source never skips elements", func(t *testing.T) {
+		memo := iter.Memoize(cursor(1, 2, 3, 4, 5, 6))
+
+		actual, err := iter.ToSlice(iter.Take(memo, 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 2}, actual)
+
+		actual, err = iter.ToSlice(memo)
+		must.ErrorIs(t, err, iter.ErrClosed)
+		must.Eq(t, []int{1, 2}, actual)
+	})
+
+	t.Run("closes source once readers stop", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		memo := iter.Memoize[int](src)
+
+		first, second := memo.Iter(), memo.Iter()
+		_, _ = first.Next()
+		_, _ = second.Next()
+
+		must.NoError(t, first.Close())
+		must.Eq(t, 0, src.closes)
+		must.NoError(t, second.Close())
+		must.Eq(t, 1, src.closes)
+
+		actual, err := iter.ToSlice(iter.Take(memo, 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actual)
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := &countingSource{values: []int{1}, err: expectedErr}
+		memo := iter.Memoize[int](src)
+
+		_, err := iter.ToSlice(memo)
+		must.ErrorIs(t, err, expectedErr)
+		_, err = iter.ToSlice(memo)
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, 1, src.closes)
+	})
+}
+
+func TestTee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sequential", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		tees := iter.Tee[int](src, 3)
+
+		for _, tee := range tees {
+			actual, err := iter.ToSlice(tee)
+			must.NoError(t, err)
+			must.Eq(t, []int{1, 3, 5}, actual)
+		}
+
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("interleaved", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		tees := iter.Tee[int](src, 2)
+		first, second := tees[0].Iter(), tees[1].Iter()
+
+		for _, expected := range []int{1, 3, 5} {
+			v, ok := first.Next()
+			must.True(t, ok)
+			must.Eq(t, expected, v)
+			v, ok = second.Next()
+			must.True(t, ok)
+			must.Eq(t, expected, v)
+		}
+
+		must.NoError(t, first.Close())
+		must.NoError(t, second.Close())
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("early close", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		tees := iter.Tee[int](src, 2)
+
+		actual, err := iter.ToSlice(iter.Take(tees[0], 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actual)
+		must.Eq(t, 0, src.closes)
+
+		actual, err = iter.ToSlice(iter.Take(tees[1], 2))
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3}, actual)
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("early close waits for unused readers", func(t *testing.T) {
+		src := &countingSource{values: []int{1, 3, 5}}
+		tees := iter.Tee[int](src, 3)
+		first, second := tees[0].Iter(), tees[1].Iter()
+
+		_, _ = first.Next()
+		must.NoError(t, first.Close())
+		_, _ = second.Next()
+		must.NoError(t, second.Close())
+		must.Eq(t, 0, src.closes)
+
+		actual, err := iter.ToSlice(tees[2])
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 3, 5}, actual)
+		must.Eq(t, 1, src.iters)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("early close surfaces source error to last reader", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := &countingSource{values: []int{1, 3, 5}, err: expectedErr}
+		tees := iter.Tee[int](src, 2)
+
+		_, err := iter.ToSlice(iter.Take(tees[0], 1))
+		must.NoError(t, err)
+
+		_, err = iter.ToSlice(iter.Take(tees[1], 1))
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, 1, src.closes)
+	})
+
+	t.Run("cursor source is shared", func(t *testing.T) {
+		tees := iter.Tee(cursor(1, 2, 3, 4, 5), 2)
+
+		actual, err := iter.ToSlice(iter.Take(tees[0], 1))
+		must.NoError(t, err)
+		must.Eq(t, []int{1}, actual)
+
+		actual, err = iter.ToSlice(tees[1])
+		must.NoError(t, err)
+		must.Eq(t, []int{1, 2, 3, 4, 5}, actual)
+	})
+
+	t.Run("source error", func(t *testing.T) {
+		expectedErr := errors.New("boom")
+		src := &countingSource{values: []int{1}, err: expectedErr}
+		tees := iter.Tee[int](src, 2)
+
+		_, err := iter.ToSlice(tees[0])
+		must.ErrorIs(t, err, expectedErr)
+		_, err = iter.ToSlice(tees[1])
+		must.ErrorIs(t, err, expectedErr)
+		must.Eq(t, 1, src.closes)
+	})
+}
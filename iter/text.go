@@ -0,0 +1,227 @@
+package iter
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+)
+
+func FromLines(r io.Reader, opts ...ReaderOpt) Iterer[string] {
+	var o readerOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return singleUse(func() Iter[string] {
+		scanner := bufio.NewScanner(r)
+		if o.maxLineLength > 0 {
+			// The buffer must also hold a "\r\n" line terminator, which leaves
+			// room for one byte too many when the line ends in a bare "\n".
+			size := o.maxLineLength + 2
+			scanner.Buffer(make([]byte, 0, min(size, 4096)), size)
+			scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+				advance, token, err := bufio.ScanLines(data, atEOF)
+				if len(token) > o.maxLineLength {
+					return 0, nil, bufio.ErrTooLong
+				}
+
+				return advance, token, err
+			})
+		}
+
+		it := &scannerIter{
+			scanner: scanner,
+		}
+
+		if closer, ok := r.(io.Closer); ok && o.closeReader {
+			it.closer = closer
+		}
+
+		return it
+	})
+}
+
+func FromRegexpMatches(re *regexp.Regexp, s string) Iterer[[]string] {
+	return ItererFunc[[]string](func() Iter[[]string] {
+		return &regexpMatchesIter{
+			re: re,
+			s:  s,
+		}
+	})
+}
+
+type regexpMatchesIter struct {
+	closeGuard
+
+	re *regexp.Regexp
+	s  string
+
+	matches [][]int
+	found   bool
+	pos     int
+}
+
+func (it *regexpMatchesIter) Next() ([]string, bool) {
+	if it.closed {
+		return nil, false
+	}
+
+	if !it.found {
+		it.found = true
+		it.matches = it.re.FindAllStringSubmatchIndex(it.s, -1)
+	}
+
+	if it.pos >= len(it.matches) {
+		return nil, false
+	}
+
+	match := it.matches[it.pos]
+	it.pos++
+
+	submatches := make([]string, len(match)/2)
+	for i := range submatches {
+		if start, end := match[2*i], match[2*i+1]; start >= 0 {
+			submatches[i] = it.s[start:end]
+		}
+	}
+
+	return submatches, true
+}
+
+func (it *regexpMatchesIter) Close() error {
+	it.closed = true
+	return nil
+}
+
+func FromRunes(s string) Iterer[rune] {
+	return ItererFunc[rune](func() Iter[rune] {
+		return &runesIter{
+			s: s,
+		}
+	})
+}
+
+type runesIter struct {
+	closeGuard
+
+	s   string
+	pos int
+}
+
+func (it *runesIter) Next() (rune, bool) {
+	if it.closed || it.pos >= len(it.s) {
+		return 0, false
+	}
+
+	r, size := utf8.DecodeRuneInString(it.s[it.pos:])
+	it.pos += size
+	return r, true
+}
+
+func (it *runesIter) Close() error {
+	it.closed = true
+	return nil
+}
+
+func FromScanner(scanner *bufio.Scanner, split bufio.SplitFunc) Iterer[string] {
+	return singleUse(func() Iter[string] {
+		if split != nil {
+			scanner.Split(split)
+		}
+
+		return &scannerIter{
+			scanner: scanner,
+		}
+	})
+}
+
+type scannerIter struct {
+	closeGuard
+
+	scanner *bufio.Scanner
+	closer  io.Closer
+}
+
+func (it *scannerIter) Next() (string, bool) {
+	if it.closed || !it.scanner.Scan() {
+		return "", false
+	}
+
+	return it.scanner.Text(), true
+}
+
+func (it *scannerIter) Close() error {
+	return it.close(func() error {
+		if it.closer == nil {
+			return it.scanner.Err()
+		}
+
+		return combineErrors(it.scanner.Err(), it.closer.Close())
+	})
+}
+
+func FromWords(s string) Iterer[string] {
+	return ItererFunc[string](func() Iter[string] {
+		return &wordsIter{
+			s: s,
+		}
+	})
+}
+
+// singleUse wraps sources that consume a reader or scanner, which cannot be
+// rewound. Every Iter after the first fails with ErrAlreadyIterated instead of
+// continuing from wherever the previous Iter stopped.
+func singleUse[T any](newIter func() Iter[T]) Iterer[T] {
+	var used atomic.Bool
+	return ItererFunc[T](func() Iter[T] {
+		if used.Swap(true) {
+			return Err[T](ErrAlreadyIterated).Iter()
+		}
+
+		return newIter()
+	})
+}
+
+type wordsIter struct {
+	closeGuard
+
+	s   string
+	pos int
+}
+
+func (it *wordsIter) Next() (string, bool) {
+	if it.closed {
+		return "", false
+	}
+
+	start := it.skip(true)
+	if start >= len(it.s) {
+		return "", false
+	}
+
+	end := it.skip(false)
+	return it.s[start:end], true
+}
+
+func (it *wordsIter) Close() error {
+	it.closed = true
+	return nil
+}
+
+// skip advances past runes whose space-ness matches space and returns the new
+// position.
+func (it *wordsIter) skip(space bool) int {
+	for it.pos < len(it.s) {
+		r, size := utf8.DecodeRuneInString(it.s[it.pos:])
+		if unicode.IsSpace(r) != space {
+			break
+		}
+
+		it.pos += size
+	}
+
+	return it.pos
+}
@@ -0,0 +1,203 @@
+package iter_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+type trackingReader struct {
+	io.Reader
+
+	closed bool
+}
+
+func (r *trackingReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestFromLines(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lines", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.FromLines(strings.NewReader("a\nbb\r\n\nccc")))
+		must.NoError(t, err)
+		must.Eq(t, []string{"a", "bb", "", "ccc"}, actual)
+	})
+
+	t.Run("max line length", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			input    string
+			expected []string
+			err      error
+		}{
+			{
+				name:     "lf",
+				input:    "abcd\nab\nabcd",
+				expected: []string{"abcd", "ab", "abcd"},
+			},
+			{
+				name:     "crlf",
+				input:    "abcd\r\nab\r\nabcd\r\n",
+				expected: []string{"abcd", "ab", "abcd"},
+			},
+			{
+				name:     "too long",
+				input:    "abcd\nabcde\nab\n",
+				expected: []string{"abcd"},
+				err:      bufio.ErrTooLong,
+			},
+			{
+				name:     "too long crlf",
+				input:    "abcd\r\nabcde\r\n",
+				expected: []string{"abcd"},
+				err:      bufio.ErrTooLong,
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				actual, err := iter.ToSlice(iter.FromLines(strings.NewReader(tc.input), iter.WithMaxLineLength(4)))
+				if tc.err != nil {
+					must.ErrorIs(t, err, tc.err)
+				} else {
+					must.NoError(t, err)
+				}
+
+				must.Eq(t, tc.expected, actual)
+			})
+		}
+	})
+
+	t.Run("single use", func(t *testing.T) {
+		lines := iter.FromLines(strings.NewReader("a\nb"))
+
+		actual, err := iter.ToSlice(iter.Take(lines, 1))
+		must.NoError(t, err)
+		must.Eq(t, []string{"a"}, actual)
+
+		actual, err = iter.ToSlice(lines)
+		must.ErrorIs(t, err, iter.ErrAlreadyIterated)
+		must.Nil(t, actual)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		r := io.MultiReader(strings.NewReader("a\nb\n"), iotestErrReader{errBoom})
+
+		actual, err := iter.ToSlice(iter.FromLines(r))
+		must.ErrorIs(t, err, errBoom)
+		must.Eq(t, []string{"a", "b"}, actual)
+	})
+
+	t.Run("close reader", func(t *testing.T) {
+		open := &trackingReader{Reader: strings.NewReader("a\nb")}
+		_, err := iter.First(iter.FromLines(open))
+		must.NoError(t, err)
+		must.False(t, open.closed)
+
+		closed := &trackingReader{Reader: strings.NewReader("a\nb")}
+		_, err = iter.First(iter.FromLines(closed, iter.WithCloseReader()))
+		must.NoError(t, err)
+		must.True(t, closed.closed)
+	})
+}
+
+type iotestErrReader struct {
+	err error
+}
+
+func (r iotestErrReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+func TestFromRegexpMatches(t *testing.T) {
+	t.Parallel()
+
+	re := regexp.MustCompile(`(\w+)=(\d+)?`)
+
+	actual, err := iter.ToSlice(iter.FromRegexpMatches(re, "a=1 b= c=3"))
+	must.NoError(t, err)
+	must.Eq(t, [][]string{{"a=1", "a", "1"}, {"b=", "b", ""}, {"c=3", "c", "3"}}, actual)
+
+	actual, err = iter.ToSlice(iter.FromRegexpMatches(re, "none"))
+	must.NoError(t, err)
+	must.Nil(t, actual)
+}
+
+func TestFromRunes(t *testing.T) {
+	t.Parallel()
+
+	actual, err := iter.ToSlice(iter.FromRunes("héllo, 世界"))
+	must.NoError(t, err)
+	must.Eq(t, []rune("héllo, 世界"), actual)
+
+	actual, err = iter.ToSlice(iter.FromRunes(""))
+	must.NoError(t, err)
+	must.Nil(t, actual)
+}
+
+func TestFromScanner(t *testing.T) {
+	t.Parallel()
+
+	scanner := bufio.NewScanner(strings.NewReader("one two  three"))
+
+	words := iter.FromScanner(scanner, bufio.ScanWords)
+
+	actual, err := iter.ToSlice(words)
+	must.NoError(t, err)
+	must.Eq(t, []string{"one", "two", "three"}, actual)
+
+	_, err = iter.ToSlice(words)
+	must.ErrorIs(t, err, iter.ErrAlreadyIterated)
+}
+
+func TestFromWords(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		s        string
+		expected []string
+	}{
+		{
+			name:     "words",
+			s:        "  the quick\tbrown\n\nfox ",
+			expected: []string{"the", "quick", "brown", "fox"},
+		},
+		{
+			name:     "unicode spaces",
+			s:        "héllo 世界",
+			expected: []string{"héllo", "世界"},
+		},
+		{
+			name:     "only spaces",
+			s:        " \t\n",
+			expected: nil,
+		},
+		{
+			name:     "empty",
+			s:        "",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(iter.FromWords(tc.s))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package iter
+
+import "time"
+
+func TimeRange(from time.Time, to time.Time, step time.Duration) Iterer[time.Time] {
+	if step == 0 {
+		panic("step must not be zero")
+	}
+
+	var count int64
+	switch dist := to.Sub(from); {
+	case step > 0 && dist > 0:
+		count = int64((dist-1)/step) + 1
+	case step < 0 && dist < 0:
+		count = int64((dist+1)/step) + 1
+	}
+
+	return &timeRangeIterer{
+		from:  from,
+		step:  step,
+		count: int(count),
+	}
+}
+
+type timeRangeIterer struct {
+	from  time.Time
+	step  time.Duration
+	count int
+}
+
+func (itr *timeRangeIterer) Iter() Iter[time.Time] {
+	return &timeRangeIter{
+		from:  itr.from,
+		step:  itr.step,
+		count: itr.count,
+	}
+}
+
+func (itr *timeRangeIterer) Len() int {
+	return itr.count
+}
+
+type timeRangeIter struct {
+	closeGuard
+
+	from  time.Time
+	step  time.Duration
+	count int
+	pos   int
+}
+
+func (it *timeRangeIter) Next() (time.Time, bool) {
+	if it.closed || it.pos >= it.count {
+		return time.Time{}, false
+	}
+
+	value := it.from.Add(time.Duration(it.pos) * it.step)
+	it.pos++
+	return value, true
+}
+
+func (it *timeRangeIter) Close() error {
+	it.closed = true
+	return nil
+}
+
+func TimeRangeMonths(from time.Time, to time.Time, months int) Iterer[time.Time] {
+	if months == 0 {
+		panic("months must not be zero")
+	}
+
+	return ItererFunc[time.Time](func() Iter[time.Time] {
+		return &timeRangeMonthsIter{
+			from:   from,
+			to:     to,
+			months: months,
+		}
+	})
+}
+
+type timeRangeMonthsIter struct {
+	closeGuard
+
+	from   time.Time
+	to     time.Time
+	months int
+	pos    int
+	done   bool
+}
+
+func (it *timeRangeMonthsIter) Next() (time.Time, bool) {
+	if it.closed || it.done {
+		return time.Time{}, false
+	}
+
+	value := addMonths(it.from, it.pos*it.months)
+	if (it.months > 0 && !value.Before(it.to)) || (it.months < 0 && !value.After(it.to)) {
+		it.done = true
+		return time.Time{}, false
+	}
+
+	it.pos++
+	return value, true
+}
+
+func (it *timeRangeMonthsIter) Close() error {
+	it.closed = true
+	return nil
+}
+
+// addMonths moves t by the given number of calendar months, clamping the day to
+// the end of the target month rather than overflowing into the next one as
+// time.Time.AddDate does.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+
+	total := int(month) - 1 + months
+	year += total / 12
+	total %= 12
+	if total < 0 {
+		total += 12
+		year--
+	}
+
+	month = time.Month(total + 1)
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, t.Location()).Day()
+
+	return time.Date(year, month, min(day, lastDay), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
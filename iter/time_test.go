@@ -0,0 +1,115 @@
+package iter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestTimeRange(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		step     time.Duration
+		expected []time.Time
+	}{
+		{
+			name: "ascending",
+			from: start,
+			to:   start.Add(5 * time.Hour),
+			step: 2 * time.Hour,
+			expected: []time.Time{
+				start,
+				start.Add(2 * time.Hour),
+				start.Add(4 * time.Hour),
+			},
+		},
+		{
+			name: "descending",
+			from: start,
+			to:   start.Add(-4 * time.Hour),
+			step: -2 * time.Hour,
+			expected: []time.Time{
+				start,
+				start.Add(-2 * time.Hour),
+			},
+		},
+		{
+			name:     "wrong direction",
+			from:     start,
+			to:       start.Add(time.Hour),
+			step:     -time.Minute,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			src := iter.TimeRange(tc.from, tc.to, tc.step)
+
+			actual, err := iter.ToSlice(src)
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+
+			length, err := iter.Len(src)
+			must.NoError(t, err)
+			must.Eq(t, len(tc.expected), length)
+		})
+	}
+}
+
+func TestTimeRangeMonths(t *testing.T) {
+	t.Parallel()
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+	}
+
+	testCases := []struct {
+		name     string
+		from     time.Time
+		to       time.Time
+		months   int
+		expected []time.Time
+	}{
+		{
+			name:     "clamps to month end",
+			from:     date(2024, time.January, 31),
+			to:       date(2024, time.May, 1),
+			months:   1,
+			expected: []time.Time{date(2024, time.January, 31), date(2024, time.February, 29), date(2024, time.March, 31), date(2024, time.April, 30)},
+		},
+		{
+			name:     "quarterly across years",
+			from:     date(2023, time.November, 15),
+			to:       date(2024, time.November, 15),
+			months:   3,
+			expected: []time.Time{date(2023, time.November, 15), date(2024, time.February, 15), date(2024, time.May, 15), date(2024, time.August, 15)},
+		},
+		{
+			name:     "descending",
+			from:     date(2024, time.March, 31),
+			to:       date(2023, time.December, 31),
+			months:   -1,
+			expected: []time.Time{date(2024, time.March, 31), date(2024, time.February, 29), date(2024, time.January, 31)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := iter.ToSlice(iter.TimeRangeMonths(tc.from, tc.to, tc.months))
+			must.NoError(t, err)
+			must.Eq(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package iter
+
+func TryFilter[S any](src Iterer[S], filter func(S) (bool, error), opts ...TryOpt) Iterer[S] {
+	return try(src, func(elem S) (S, bool, error) {
+		keep, err := filter(elem)
+		return elem, keep, err
+	}, opts)
+}
+
+func TrySelect[S, R any](src Iterer[S], selector func(S) (R, error), opts ...TryOpt) Iterer[R] {
+	return try(src, func(elem S) (R, bool, error) {
+		value, err := selector(elem)
+		return value, true, err
+	}, opts)
+}
+
+func TrySelectMany[S, R any](src Iterer[S], selector func(S) (Iterer[R], error), opts ...TryOpt) Iterer[R] {
+	return SelectMany(TrySelect(src, selector, opts...), identity[Iterer[R]])
+}
+
+func try[S, R any](src Iterer[S], fn func(S) (R, bool, error), opts []TryOpt) Iterer[R] {
+	var o tryOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return ItererFunc[R](func() Iter[R] {
+		return &tryIter[S, R]{
+			src:             src.Iter(),
+			fn:              fn,
+			continueOnError: o.continueOnError,
+		}
+	})
+}
+
+type tryIter[S, R any] struct {
+	closeGuard
+
+	src             Iter[S]
+	fn              func(S) (R, bool, error)
+	continueOnError bool
+
+	errs []error
+}
+
+func (it *tryIter[S, R]) Next() (R, bool) {
+	if it.closed || (len(it.errs) > 0 && !it.continueOnError) {
+		var def R
+		return def, false
+	}
+
+	for {
+		elem, ok := it.src.Next()
+		if !ok {
+			var def R
+			return def, false
+		}
+
+		value, keep, err := it.fn(elem)
+		if err != nil {
+			it.errs = append(it.errs, err)
+			if !it.continueOnError {
+				var def R
+				return def, false
+			}
+
+			continue
+		}
+
+		if keep {
+			return value, true
+		}
+	}
+}
+
+func (it *tryIter[S, R]) Close() error {
+	return it.close(func() error {
+		return combineErrors(append(it.errs, it.src.Close())...)
+	})
+}
@@ -0,0 +1,123 @@
+package iter_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/iter"
+)
+
+func TestTryFilter(t *testing.T) {
+	t.Parallel()
+
+	errOdd := errors.New("odd")
+	filter := func(i int) (bool, error) {
+		if i%2 != 0 {
+			return false, errOdd
+		}
+		return i > 2, nil
+	}
+
+	t.Run("no errors", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.TryFilter(iter.FromSlice([]int{2, 4, 6}), filter))
+		must.NoError(t, err)
+		must.Eq(t, []int{4, 6}, actual)
+	})
+
+	t.Run("stop on error", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.TryFilter(iter.FromSlice([]int{4, 5, 6}), filter))
+		must.ErrorIs(t, err, errOdd)
+		must.Eq(t, []int{4}, actual)
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.TryFilter(iter.FromSlice([]int{4, 5, 6, 7}), filter, iter.WithContinueOnError()))
+		must.ErrorIs(t, err, errOdd)
+		must.Eq(t, []int{4, 6}, actual)
+	})
+}
+
+func TestTrySelect(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    []string
+		opts     []iter.TryOpt
+		expected []int
+		errs     int
+	}{
+		{
+			name:     "no errors",
+			input:    []string{"1", "2", "3"},
+			expected: []int{1, 2, 3},
+		},
+		{
+			name:     "stop on error",
+			input:    []string{"1", "x", "3", "y"},
+			expected: []int{1},
+			errs:     1,
+		},
+		{
+			name:     "continue on error",
+			input:    []string{"1", "x", "3", "y"},
+			opts:     []iter.TryOpt{iter.WithContinueOnError()},
+			expected: []int{1, 3},
+			errs:     2,
+		},
+		{
+			name:     "empty",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			it := iter.FromSlice(tc.input)
+
+			actual, err := iter.ToSlice(iter.TrySelect(it, strconv.Atoi, tc.opts...))
+			must.Eq(t, tc.expected, actual)
+			if tc.errs == 0 {
+				must.NoError(t, err)
+				return
+			}
+
+			var numErr *strconv.NumError
+			must.True(t, errors.As(err, &numErr))
+			if tc.errs > 1 {
+				var multi *iter.MultiError
+				must.True(t, errors.As(err, &multi))
+				must.Len(t, tc.errs, multi.Errors)
+			}
+		})
+	}
+}
+
+func TestTrySelectMany(t *testing.T) {
+	t.Parallel()
+
+	errNegative := errors.New("negative")
+	selector := func(i int) (iter.Iterer[int], error) {
+		if i < 0 {
+			return nil, errNegative
+		}
+		return iter.Repeat(i, i), nil
+	}
+
+	t.Run("stop on error", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.TrySelectMany(iter.FromSlice([]int{1, 2, -1, 3}), selector))
+		must.ErrorIs(t, err, errNegative)
+		must.Eq(t, []int{1, 2, 2}, actual)
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		actual, err := iter.ToSlice(iter.TrySelectMany(iter.FromSlice([]int{1, -1, 2}), selector, iter.WithContinueOnError()))
+		must.ErrorIs(t, err, errNegative)
+		must.Eq(t, []int{1, 2, 2}, actual)
+	})
+}
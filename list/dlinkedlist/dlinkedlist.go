@@ -31,6 +31,18 @@ func (l *DLinkedList[T]) Add(v T) {
 	l.insertAt(n, l.root.prev)
 }
 
+func (l *DLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for n := l.root.next; n != &l.root; n = n.next {
+			if !yield(i, n.value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
 func (l *DLinkedList[T]) ElementAt(idx int) T {
 	n := l.nodeAt(idx)
 	if n != nil {
@@ -40,6 +52,16 @@ func (l *DLinkedList[T]) ElementAt(idx int) T {
 	return n.value
 }
 
+func (l *DLinkedList[T]) Elements() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.root.next; n != &l.root; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
 func (l *DLinkedList[T]) InsertAt(idx int, v T) {
 	at := l.nodeAt(idx)
 	n := &node[T]{value: v}
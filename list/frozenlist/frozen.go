@@ -15,10 +15,18 @@ type Frozen[T any] struct {
 	l list.ReadOnly[T]
 }
 
+func (l *Frozen[T]) All() iter.Seq2[int, T] {
+	return l.l.All()
+}
+
 func (l *Frozen[T]) ElementAt(idx int) T {
 	return l.l.ElementAt(idx)
 }
 
+func (l *Frozen[T]) Elements() iter.Seq[T] {
+	return l.l.Elements()
+}
+
 func (l *Frozen[T]) Iter() iter.Iter[T] {
 	return l.l.Iter()
 }
@@ -0,0 +1,60 @@
+package frozenlist_test
+
+import (
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/list/frozenlist"
+	"github.com/craiggwilson/go-collections/list/slicelist"
+)
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	l := frozenlist.NewFrozen[int](slicelist.FromSlice([]int{10, 20, 30}))
+
+	var indexes, values []int
+	for i, v := range l.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+
+	must.Eq(t, []int{0, 1, 2}, indexes)
+	must.Eq(t, []int{10, 20, 30}, values)
+
+	var partial []int
+	for _, v := range l.All() {
+		if v > 20 {
+			break
+		}
+
+		partial = append(partial, v)
+	}
+
+	must.Eq(t, []int{10, 20}, partial)
+}
+
+func TestElements(t *testing.T) {
+	t.Parallel()
+
+	l := frozenlist.NewFrozen[int](slicelist.FromSlice([]int{10, 20, 30}))
+
+	var values []int
+	for v := range l.Elements() {
+		values = append(values, v)
+	}
+
+	must.Eq(t, []int{10, 20, 30}, values)
+
+	var partial []int
+	for v := range l.Elements() {
+		if v > 10 {
+			break
+		}
+
+		partial = append(partial, v)
+	}
+
+	must.Eq(t, []int{10}, partial)
+}
@@ -5,7 +5,9 @@ import "github.com/craiggwilson/go-collections/iter"
 type ReadOnly[T any] interface {
 	iter.Iterer[T]
 
+	All() iter.Seq2[int, T]
 	ElementAt(int) T
+	Elements() iter.Seq[T]
 	Len() int
 }
 
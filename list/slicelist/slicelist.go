@@ -42,10 +42,30 @@ func (l *SliceList[T]) AddRange(v []T) {
 	l.values = append(l.values, v...)
 }
 
+func (l *SliceList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range l.values {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
 func (l *SliceList[T]) ElementAt(idx int) T {
 	return l.values[idx]
 }
 
+func (l *SliceList[T]) Elements() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 func (l *SliceList[T]) InsertAt(idx int, v T) {
 	l.values = slices.Insert(l.values, idx, v)
 }
@@ -15,6 +15,10 @@ type FrozenSet[T comparable] struct {
 	s set.ReadOnly[T]
 }
 
+func (s *FrozenSet[T]) All() iter.Seq[T] {
+	return s.s.All()
+}
+
 func (s *FrozenSet[T]) Contains(v T) bool {
 	return s.s.Contains(v)
 }
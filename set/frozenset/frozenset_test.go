@@ -0,0 +1,42 @@
+package set_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	frozenset "github.com/craiggwilson/go-collections/set/frozenset"
+	"github.com/craiggwilson/go-collections/set/mapset"
+)
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	s := frozenset.New[int](newSet(10, 20, 30))
+
+	var values []int
+	for v := range s.All() {
+		values = append(values, v)
+	}
+
+	slices.Sort(values)
+	must.Eq(t, []int{10, 20, 30}, values)
+
+	count := 0
+	for range s.All() {
+		count++
+		break
+	}
+
+	must.Eq(t, 1, count)
+}
+
+func newSet(values ...int) *mapset.MapSet[int] {
+	s := mapset.New[int]()
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}
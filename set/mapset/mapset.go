@@ -31,6 +31,16 @@ func (s *MapSet[T]) Add(v T) {
 	s.values[v] = struct{}{}
 }
 
+func (s *MapSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 func (s *MapSet[T]) Clear() {
 	s.values = make(map[T]struct{})
 }
@@ -5,6 +5,7 @@ import "github.com/craiggwilson/go-collections/iter"
 type ReadOnly[T comparable] interface {
 	iter.Iterer[T]
 
+	All() iter.Seq[T]
 	Contains(T) bool
 	Len() int
 }
@@ -33,6 +33,16 @@ func (s *SliceSet[T]) Add(v T) {
 	s.values = append(s.values, v)
 }
 
+func (s *SliceSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s.values {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 func (s *SliceSet[T]) Clear() {
 	s.values = s.values[:0]
 }
@@ -0,0 +1,41 @@
+package sliceset_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/shoenig/test/must"
+
+	"github.com/craiggwilson/go-collections/set/sliceset"
+)
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	s := newSet(10, 20, 30)
+
+	var values []int
+	for v := range s.All() {
+		values = append(values, v)
+	}
+
+	slices.Sort(values)
+	must.Eq(t, []int{10, 20, 30}, values)
+
+	count := 0
+	for range s.All() {
+		count++
+		break
+	}
+
+	must.Eq(t, 1, count)
+}
+
+func newSet(values ...int) *sliceset.SliceSet[int] {
+	s := sliceset.New[int]()
+	for _, v := range values {
+		s.Add(v)
+	}
+
+	return s
+}